@@ -10,6 +10,7 @@ import (
 
 	"github.com/senzing-garage/go-helpers/wraperror"
 	"github.com/senzing-garage/validate/cmd"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 )
 
@@ -35,6 +36,31 @@ func Test_DocsCmd(test *testing.T) {
 	require.NoError(test, err)
 }
 
+func Test_FixCmd_no_output_file(test *testing.T) {
+	err := cmd.FixCmd.RunE(cmd.FixCmd, []string{})
+	require.Error(test, err)
+}
+
+func Test_FixCmd_head(test *testing.T) {
+	viper.Set(cmd.FixOutputFile.Arg, filepath.Join(test.TempDir(), "fixed.jsonl"))
+	viper.Set(cmd.Head.Arg, 3)
+	test.Cleanup(func() {
+		viper.Set(cmd.FixOutputFile.Arg, "")
+		viper.Set(cmd.Head.Arg, 0)
+	})
+
+	err := cmd.FixCmd.RunE(cmd.FixCmd, []string{})
+	require.Error(test, err)
+}
+
+func Test_RunE_sample_rate_not_a_number(test *testing.T) {
+	viper.Set(cmd.SampleRate.Arg, "one percent")
+	test.Cleanup(func() { viper.Set(cmd.SampleRate.Arg, "") })
+
+	err := cmd.RunE(cmd.RootCmd, []string{})
+	require.ErrorContains(test, err, "sample-rate is not a number")
+}
+
 func Test_Execute(test *testing.T) {
 	_ = test
 	os.Args = []string{"command-name", "--help"}
@@ -0,0 +1,67 @@
+/*
+ */
+package cmd
+
+import (
+	"context"
+	"slices"
+
+	"github.com/senzing-garage/go-cmdhelping/cmdhelper"
+	"github.com/senzing-garage/go-cmdhelping/option"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var FixContextVariables = slices.Concat(ContextVariables, []option.ContextVariable{
+	FixOutputFile,
+	Repairs,
+})
+
+// FixCmd represents the fix command.
+var FixCmd = &cobra.Command{
+	Use:   "fix",
+	Short: "Writes a repaired copy of a JSON-lines file",
+	Long: `
+    Validate a JSON-lines (JSONL) file as validate does, writing each record to a new
+    JSONL file with mechanical problems repaired and logging every change made.
+
+    Usage example:
+
+    validate fix --input-url "file:///path/to/json/lines/file.jsonl" --output-file /path/to/fixed.jsonl
+    validate fix --input-url "file:///path/to/json/lines/file.jsonl" --output-file /path/to/fixed.jsonl \
+        --repairs whitespace --rename SOURCE_ID=DATA_SOURCE --default-data-source CUSTOMERS
+    `,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		cmdhelper.PreRun(cmd, args, Use, FixContextVariables)
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		_ = cmd
+		_ = args
+
+		ctx, stop := signalContext()
+		defer stop()
+
+		return fixAction(ctx)
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(FixCmd)
+	cmdhelper.Init(FixCmd, FixContextVariables)
+}
+
+func fixAction(ctx context.Context) error {
+	validator, err := newValidator()
+	if err != nil {
+		return err
+	}
+
+	validator.FixOutputFile = viper.GetString(FixOutputFile.Arg)
+	validator.Repairs = viper.GetStringSlice(Repairs.Arg)
+
+	if !validator.Fix(ctx) {
+		return readError(ctx, "Fix")
+	}
+
+	return nil
+}
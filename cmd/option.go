@@ -0,0 +1,234 @@
+package cmd
+
+import (
+	"github.com/senzing-garage/go-cmdhelping/option"
+	"github.com/senzing-garage/go-cmdhelping/option/optiontype"
+)
+
+// ----------------------------------------------------------------------------
+// Context variables specific to validate
+// ----------------------------------------------------------------------------
+
+var AllowNumericRecordID = option.ContextVariable{
+	Arg:     "allow-numeric-record-id",
+	Default: option.OsLookupEnvBool("SENZING_TOOLS_ALLOW_NUMERIC_RECORD_ID", false),
+	Envar:   "SENZING_TOOLS_ALLOW_NUMERIC_RECORD_ID",
+	Help:    "Accept a RECORD_ID given as a JSON number instead of a string [%s]",
+	Type:    optiontype.Bool,
+}
+
+var DefaultDataSource = option.ContextVariable{
+	Arg:     "default-data-source",
+	Default: option.OsLookupEnvString("SENZING_TOOLS_DEFAULT_DATA_SOURCE", ""),
+	Envar:   "SENZING_TOOLS_DEFAULT_DATA_SOURCE",
+	Help:    "DATA_SOURCE set on records that have none before they are validated [%s]",
+	Type:    optiontype.String,
+}
+
+var DefaultPhoneRegion = option.ContextVariable{
+	Arg:     "default-phone-region",
+	Default: option.OsLookupEnvString("SENZING_TOOLS_DEFAULT_PHONE_REGION", ""),
+	Envar:   "SENZING_TOOLS_DEFAULT_PHONE_REGION",
+	Help:    "ISO 3166 region, e.g. US, of phone numbers not in international format; they are not checked if unset [%s]",
+	Type:    optiontype.String,
+}
+
+var DropAttribute = option.ContextVariable{
+	Arg:     "drop-attribute",
+	Default: []string{},
+	Envar:   "SENZING_TOOLS_DROP_ATTRIBUTE",
+	Help:    "Attribute removed from records before they are validated; may be repeated [%s]",
+	Type:    optiontype.StringSlice,
+}
+
+var EmptyValuesAsErrors = option.ContextVariable{
+	Arg:     "empty-values-as-errors",
+	Default: option.OsLookupEnvBool("SENZING_TOOLS_EMPTY_VALUES_AS_ERRORS", false),
+	Envar:   "SENZING_TOOLS_EMPTY_VALUES_AS_ERRORS",
+	Help:    "Count records with null, empty or white space values as bad, instead of only warning [%s]",
+	Type:    optiontype.Bool,
+}
+
+var FixOutputFile = option.ContextVariable{
+	Arg:     "output-file",
+	Default: option.OsLookupEnvString("SENZING_TOOLS_OUTPUT_FILE", ""),
+	Envar:   "SENZING_TOOLS_OUTPUT_FILE",
+	Help:    "JSONL file written with every record, with the selected repairs applied [%s]",
+	Type:    optiontype.String,
+}
+
+var Head = option.ContextVariable{
+	Arg:     "head",
+	Default: option.OsLookupEnvInt("SENZING_TOOLS_HEAD", 0),
+	Envar:   "SENZING_TOOLS_HEAD",
+	Help:    "Validate only the first N records, after those skipped: lines of JSONL, elements of a JSON array or Parquet rows; 0 is all records [%s]",
+	Type:    optiontype.Int,
+}
+
+var HTTPBearerToken = option.ContextVariable{
+	Arg:     "http-bearer-token",
+	Default: option.OsLookupEnvString("SENZING_TOOLS_HTTP_BEARER_TOKEN", ""),
+	Envar:   "SENZING_TOOLS_HTTP_BEARER_TOKEN",
+	Help:    "Bearer token sent in the Authorization header of http(s) input URL requests [%s]",
+	Type:    optiontype.String,
+}
+
+var HTTPCABundle = option.ContextVariable{
+	Arg:     "http-ca-bundle",
+	Default: option.OsLookupEnvString("SENZING_TOOLS_HTTP_CA_BUNDLE", ""),
+	Envar:   "SENZING_TOOLS_HTTP_CA_BUNDLE",
+	Help:    "Path to a PEM file of additional certificate authorities trusted for https input URLs [%s]",
+	Type:    optiontype.String,
+}
+
+var HTTPHeader = option.ContextVariable{
+	Arg:     "http-header",
+	Default: []string{},
+	Envar:   "SENZING_TOOLS_HTTP_HEADER",
+	Help:    "Header, in 'Name: value' form, sent with http(s) input URL requests; may be repeated [%s]",
+	Type:    optiontype.StringSlice,
+}
+
+var HTTPRetries = option.ContextVariable{
+	Arg:     "http-retries",
+	Default: option.OsLookupEnvInt("SENZING_TOOLS_HTTP_RETRIES", 3),
+	Envar:   "SENZING_TOOLS_HTTP_RETRIES",
+	Help:    "Number of times a failed http(s) input URL request is retried, with exponential backoff [%s]",
+	Type:    optiontype.Int,
+}
+
+var HTTPTimeoutInSeconds = option.ContextVariable{
+	Arg:     "http-timeout-in-seconds",
+	Default: option.OsLookupEnvInt("SENZING_TOOLS_HTTP_TIMEOUT_IN_SECONDS", 30),
+	Envar:   "SENZING_TOOLS_HTTP_TIMEOUT_IN_SECONDS",
+	Help:    "Seconds to wait for the response headers, and for each read of the body, of an http(s) input URL request; 0 waits forever [%s]",
+	Type:    optiontype.Int,
+}
+
+var InputEncoding = option.ContextVariable{
+	Arg:     "input-encoding",
+	Default: option.OsLookupEnvString("SENZING_TOOLS_INPUT_ENCODING", ""),
+	Envar:   "SENZING_TOOLS_INPUT_ENCODING",
+	Help:    "Input character encoding, e.g. UTF-16LE or windows-1252; default is UTF-8 or the byte order mark [%s]",
+	Type:    optiontype.String,
+}
+
+var JunkNames = option.ContextVariable{
+	Arg:     "junk-names",
+	Default: []string{},
+	Envar:   "SENZING_TOOLS_JUNK_NAMES",
+	Help:    "Placeholder values, such as UNKNOWN, reported when given as a name; replaces the built-in list [%s]",
+	Type:    optiontype.StringSlice,
+}
+
+var LenientKeys = option.ContextVariable{
+	Arg:     "lenient-keys",
+	Default: option.OsLookupEnvBool("SENZING_TOOLS_LENIENT_KEYS", false),
+	Envar:   "SENZING_TOOLS_LENIENT_KEYS",
+	Help:    "Accept keys differing only in case or white space from known attributes, e.g. record_id, only warning [%s]",
+	Type:    optiontype.Bool,
+}
+
+var MaxAttributes = option.ContextVariable{
+	Arg:     "max-attributes",
+	Default: option.OsLookupEnvInt("SENZING_TOOLS_MAX_ATTRIBUTES", 1000),
+	Envar:   "SENZING_TOOLS_MAX_ATTRIBUTES",
+	Help:    "Maximum number of attributes in a record, including those in its lists; 0 is no limit [%s]",
+	Type:    optiontype.Int,
+}
+
+var MaxListElements = option.ContextVariable{
+	Arg:     "max-list-elements",
+	Default: option.OsLookupEnvInt("SENZING_TOOLS_MAX_LIST_ELEMENTS", 1000),
+	Envar:   "SENZING_TOOLS_MAX_LIST_ELEMENTS",
+	Help:    "Maximum number of elements in a list, e.g. of ADDRESSES; 0 is no limit [%s]",
+	Type:    optiontype.Int,
+}
+
+var MaxRecordBytes = option.ContextVariable{
+	Arg:     "max-record-bytes",
+	Default: option.OsLookupEnvInt("SENZING_TOOLS_MAX_RECORD_BYTES", 1048576),
+	Envar:   "SENZING_TOOLS_MAX_RECORD_BYTES",
+	Help:    "Maximum size of a record in bytes; 0 is no limit [%s]",
+	Type:    optiontype.Int,
+}
+
+var MaxStringLength = option.ContextVariable{
+	Arg:     "max-string-length",
+	Default: option.OsLookupEnvInt("SENZING_TOOLS_MAX_STRING_LENGTH", 10000),
+	Envar:   "SENZING_TOOLS_MAX_STRING_LENGTH",
+	Help:    "Maximum length of a value in characters; 0 is no limit [%s]",
+	Type:    optiontype.Int,
+}
+
+var NormalizedOutputFile = option.ContextVariable{
+	Arg:     "normalized-output-file",
+	Default: option.OsLookupEnvString("SENZING_TOOLS_NORMALIZED_OUTPUT_FILE", ""),
+	Envar:   "SENZING_TOOLS_NORMALIZED_OUTPUT_FILE",
+	Help:    "JSONL file written with every record, its control and invisible characters removed and text in NFC [%s]",
+	Type:    optiontype.String,
+}
+
+var ProgressIntervalInSeconds = option.ContextVariable{
+	Arg:     "progress-interval-in-seconds",
+	Default: option.OsLookupEnvInt("SENZING_TOOLS_PROGRESS_INTERVAL_IN_SECONDS", 60),
+	Envar:   "SENZING_TOOLS_PROGRESS_INTERVAL_IN_SECONDS",
+	Help:    "Seconds between progress reports written to stderr; 0 is none [%s]",
+	Type:    optiontype.Int,
+}
+
+var RecordTypes = option.ContextVariable{
+	Arg:     "record-types",
+	Default: []string{},
+	Envar:   "SENZING_TOOLS_RECORD_TYPES",
+	Help:    "RECORD_TYPE values allowed, e.g. those of the Senzing configuration; default is PERSON, ORGANIZATION, VESSEL and AIRCRAFT [%s]",
+	Type:    optiontype.StringSlice,
+}
+
+var Rename = option.ContextVariable{
+	Arg:     "rename",
+	Default: []string{},
+	Envar:   "SENZING_TOOLS_RENAME",
+	Help:    "Attribute renamed, in KEY=NEWKEY form, before records are validated; may be repeated [%s]",
+	Type:    optiontype.StringSlice,
+}
+
+var Repairs = option.ContextVariable{
+	Arg:     "repairs",
+	Default: []string{},
+	Envar:   "SENZING_TOOLS_REPAIRS",
+	Help:    "Repairs applied: bom, crlf, key-case, numeric-record-id and whitespace; default is all of them [%s]",
+	Type:    optiontype.StringSlice,
+}
+
+var S3Endpoint = option.ContextVariable{
+	Arg:     "s3-endpoint",
+	Default: option.OsLookupEnvString("SENZING_TOOLS_S3_ENDPOINT", ""),
+	Envar:   "SENZING_TOOLS_S3_ENDPOINT",
+	Help:    "Endpoint URL of an S3-compatible object store used for s3:// input URLs [%s]",
+	Type:    optiontype.String,
+}
+
+var SampleRate = option.ContextVariable{
+	Arg:     "sample-rate",
+	Default: option.OsLookupEnvString("SENZING_TOOLS_SAMPLE_RATE", ""),
+	Envar:   "SENZING_TOOLS_SAMPLE_RATE",
+	Help:    "Validate a random fraction, e.g. 0.01, of the records, whether lines of JSONL, elements of a JSON array or Parquet rows, and estimate the bad records; default is all records [%s]",
+	Type:    optiontype.String,
+}
+
+var SampleSeed = option.ContextVariable{
+	Arg:     "sample-seed",
+	Default: option.OsLookupEnvUint64("SENZING_TOOLS_SAMPLE_SEED", 0),
+	Envar:   "SENZING_TOOLS_SAMPLE_SEED",
+	Help:    "Seed choosing the records validated with sample-rate, to repeat a sample; 0 is a random seed, which is logged [%s]",
+	Type:    optiontype.Uint64,
+}
+
+var Skip = option.ContextVariable{
+	Arg:     "skip",
+	Default: option.OsLookupEnvInt("SENZING_TOOLS_SKIP", 0),
+	Envar:   "SENZING_TOOLS_SKIP",
+	Help:    "Skip the first N records without validating them: lines of JSONL, elements of a JSON array or Parquet rows [%s]",
+	Type:    optiontype.Int,
+}
@@ -7,6 +7,9 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
 	"time"
 
 	"github.com/senzing-garage/go-cmdhelping/cmdhelper"
@@ -28,6 +31,9 @@ const (
 
     validate --input-url "file:///path/to/json/lines/file.jsonl"
     validate --input-url "https://public-read-access.s3.amazonaws.com/TestDataSets/SenzingTruthSet/truth-set-3.0.0.jsonl"
+    validate --input-url "s3://public-read-access/TestDataSets/SenzingTruthSet/truth-set-3.0.0.jsonl"
+    validate --input-url "file:///path/to/parquet/file.parquet"
+    validate < /path/to/json/lines/file.jsonl
     `
 )
 
@@ -37,16 +43,49 @@ const (
 
 var ContextVariablesForMultiPlatform = []option.ContextVariable{
 	option.CoreInstanceName.SetDefault(fmt.Sprintf("validate-%d", time.Now().Unix())),
+	AllowNumericRecordID,
+	DefaultDataSource,
+	DefaultPhoneRegion,
+	DropAttribute,
+	EmptyValuesAsErrors,
+	Head,
+	HTTPBearerToken,
+	HTTPCABundle,
+	HTTPHeader,
+	HTTPRetries,
+	HTTPTimeoutInSeconds,
+	InputEncoding,
 	option.InputFileType,
 	option.InputURL,
 	option.JSONOutput,
+	JunkNames,
+	LenientKeys,
 	option.LogLevel,
+	MaxAttributes,
+	MaxListElements,
+	MaxRecordBytes,
+	MaxStringLength,
+	NormalizedOutputFile,
+	ProgressIntervalInSeconds,
+	RecordTypes,
+	Rename,
+	S3Endpoint,
+	SampleRate,
+	SampleSeed,
+	Skip,
 }
 
 var ContextVariables = append(ContextVariablesForMultiPlatform, ContextVariablesForOsArch...)
 
 var errPackage = errors.New("cmd")
 
+// Returned when a command is stopped by SIGINT or SIGTERM.
+var errCanceled = errors.New("canceled")
+
+// The exit code when a command is stopped by SIGINT or SIGTERM, that of a
+// shell for SIGINT, so it is told apart from records that did not validate.
+const exitCodeCanceled = 130
+
 // ----------------------------------------------------------------------------
 // Command
 // ----------------------------------------------------------------------------
@@ -69,6 +108,10 @@ var RootCmd = &cobra.Command{
 // This is called by main.main(). It only needs to happen once to the RootCmd.
 func Execute() {
 	err := RootCmd.Execute()
+	if errors.Is(err, errCanceled) {
+		os.Exit(exitCodeCanceled)
+	}
+
 	if err != nil {
 		os.Exit(1)
 	}
@@ -83,17 +126,16 @@ func PreRun(cobraCommand *cobra.Command, args []string) {
 func RunE(_ *cobra.Command, _ []string) error {
 	var err error
 
-	ctx := context.Background()
+	ctx, stop := signalContext()
+	defer stop()
 
-	validator := &validate.BasicValidate{
-		InputFileType: viper.GetString(option.InputFileType.Arg),
-		InputURL:      viper.GetString(option.InputURL.Arg),
-		JSONOutput:    viper.GetBool(option.JSONOutput.Arg),
-		LogLevel:      viper.GetString(option.LogLevel.Arg),
+	validator, err := newValidator()
+	if err != nil {
+		return err
 	}
 
 	if !validator.Read(ctx) {
-		err = wraperror.Errorf(errPackage, "Read")
+		err = readError(ctx, "Read")
 	}
 
 	return err
@@ -108,6 +150,78 @@ func Version() string {
 // Private functions
 // ----------------------------------------------------------------------------
 
+// Create a validator configured by the options shared by all commands.
+func newValidator() (*validate.BasicValidate, error) {
+	var (
+		err        error
+		sampleRate float64
+	)
+
+	if viper.GetString(SampleRate.Arg) != "" {
+		sampleRate, err = strconv.ParseFloat(viper.GetString(SampleRate.Arg), 64)
+		if err != nil {
+			return nil, wraperror.Errorf(err, "%s is not a number: %s", SampleRate.Arg, viper.GetString(SampleRate.Arg))
+		}
+	}
+
+	return &validate.BasicValidate{
+		AllowNumericRecordID: viper.GetBool(AllowNumericRecordID.Arg),
+		DefaultDataSource:    viper.GetString(DefaultDataSource.Arg),
+		DefaultPhoneRegion:   viper.GetString(DefaultPhoneRegion.Arg),
+		DropAttributes:       viper.GetStringSlice(DropAttribute.Arg),
+		EmptyValuesAsErrors:  viper.GetBool(EmptyValuesAsErrors.Arg),
+		Head:                 viper.GetInt(Head.Arg),
+		HTTPBearerToken:      viper.GetString(HTTPBearerToken.Arg),
+		HTTPCABundle:         viper.GetString(HTTPCABundle.Arg),
+		HTTPHeaders:          viper.GetStringSlice(HTTPHeader.Arg),
+		HTTPRetries:          viper.GetInt(HTTPRetries.Arg),
+		HTTPTimeout:          time.Duration(viper.GetInt(HTTPTimeoutInSeconds.Arg)) * time.Second,
+		InputEncoding:        viper.GetString(InputEncoding.Arg),
+		InputFileType:        viper.GetString(option.InputFileType.Arg),
+		InputURL:             viper.GetString(option.InputURL.Arg),
+		JSONOutput:           viper.GetBool(option.JSONOutput.Arg),
+		JunkNames:            viper.GetStringSlice(JunkNames.Arg),
+		LenientKeys:          viper.GetBool(LenientKeys.Arg),
+		LogLevel:             viper.GetString(option.LogLevel.Arg),
+		MaxAttributes:        viper.GetInt(MaxAttributes.Arg),
+		MaxListElements:      viper.GetInt(MaxListElements.Arg),
+		MaxRecordBytes:       viper.GetInt(MaxRecordBytes.Arg),
+		MaxStringLength:      viper.GetInt(MaxStringLength.Arg),
+		NormalizedOutputFile: viper.GetString(NormalizedOutputFile.Arg),
+		ProgressInterval:     time.Duration(viper.GetInt(ProgressIntervalInSeconds.Arg)) * time.Second,
+		RecordTypes:          viper.GetStringSlice(RecordTypes.Arg),
+		RenameAttributes:     viper.GetStringSlice(Rename.Arg),
+		S3Endpoint:           viper.GetString(S3Endpoint.Arg),
+		SampleRate:           sampleRate,
+		SampleSeed:           viper.GetUint64(SampleSeed.Arg),
+		Skip:                 viper.GetInt(Skip.Arg),
+	}, nil
+}
+
+// ----------------------------------------------------------------------------
+
+// The error of a command that failed: errCanceled, unwrapped so Execute finds
+// it, if the context was canceled.
+func readError(ctx context.Context, action string) error {
+	if ctx.Err() != nil {
+		return errCanceled
+	}
+
+	return wraperror.Errorf(errPackage, "%s", action)
+}
+
+// ----------------------------------------------------------------------------
+
+// A context canceled by SIGINT or SIGTERM, so that a command that is stopped
+// still logs the summary of the records it read.  After the first signal, a
+// second one ends the process at once, as it would without this.
+func signalContext() (context.Context, context.CancelFunc) {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	context.AfterFunc(ctx, stop)
+
+	return ctx, stop
+}
+
 // Since init() is always invoked, define command line parameters.
 func init() {
 	cmdhelper.Init(RootCmd, ContextVariables)
@@ -0,0 +1,61 @@
+package validate
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Address attributes, optionally prefixed by a usage type such as "HOME_".
+var addressAttribute = regexp.MustCompile(`^(.*_)?ADDR_(FULL|LINE[1-6]|CITY|STATE|POSTAL_CODE|COUNTRY)$`)
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Report addresses that give a city, state, postal code or country without a
+// street (ADDR_LINE1 or ADDR_FULL), addresses that combine ADDR_FULL with
+// parsed attributes, and ADDR_COUNTRY values that are not ISO 3166 countries.
+// Each object, and each usage type prefix within it, is a separate address.
+func (validate *BasicValidate) checkAddresses(object jsonObject) []issue {
+	var issues []issue
+
+	walkJSON(object, "", func(path string, value any) {
+		addressObject, isObject := value.(jsonObject)
+		if !isObject {
+			return
+		}
+
+		for _, group := range attributeGroups(path, addressObject, addressAttribute, "ADDR_") {
+			hasFull := slices.Contains(group.attributes, "FULL")
+			hasStreet := slices.ContainsFunc(group.attributes, func(field string) bool {
+				return strings.HasPrefix(field, "LINE")
+			})
+			parsed := slices.DeleteFunc(slices.Clone(group.attributes), func(field string) bool {
+				return field == "FULL" || field == "COUNTRY"
+			})
+
+			switch {
+			case hasFull && len(parsed) > 0:
+				issues = append(issues, newWarning(3042, 3043, group.label, group.list(parsed)))
+			case !hasFull && !hasStreet:
+				issues = append(issues, newWarning(3040, 3041, group.label, group.list(group.attributes)))
+			}
+		}
+
+		for _, member := range addressObject {
+			match := addressAttribute.FindStringSubmatch(member.Key)
+			country, isString := member.Value.(string)
+
+			if match == nil || match[2] != "COUNTRY" || !isString || strings.TrimSpace(country) == "" {
+				continue
+			}
+
+			if _, isFound := lookupCountry(country); !isFound {
+				issues = append(issues, newWarning(3044, 3045, joinJSONPath(path, member.Key), country))
+			}
+		}
+	})
+
+	return issues
+}
@@ -0,0 +1,61 @@
+//go:build !windows
+
+package validate_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/senzing-garage/validate/validate"
+	"github.com/stretchr/testify/require"
+)
+
+const testAddressData = `{"DATA_SOURCE": "TEST", "RECORD_ID": "1", "ADDR_CITY": "Las Vegas", "ADDR_STATE": "NV"}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "2", "ADDR_POSTAL_CODE": "89111"}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "3", "ADDR_FULL": "1 Main St, Las Vegas", "ADDR_CITY": "Las Vegas", "ADDR_COUNTRY": "US"}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "4", "ADDRESSES": [{"ADDR_LINE1": "1 Main St", "ADDR_COUNTRY": "Narnia"}, {"HOME_ADDR_CITY": "Paris", "WORK_ADDR_FULL": "2 Rue de la Paix", "WORK_ADDR_COUNTRY": "côte d'ivoire"}]}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "5", "ADDR_LINE1": "1 Main St", "ADDR_CITY": "Las Vegas", "ADDR_COUNTRY": "840"}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "6", "ADDR_LINE1": "1 Main St", "ADDR_COUNTRY": "St. Kitts & Nevis"}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "7", "ADDR_LINE1": "1 Main St", "ADDR_COUNTRY": "United States of America"}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "8", "ADDR_LINE1": "1 Main St", "ADDR_COUNTRY": "gbr"}
+`
+
+// ----------------------------------------------------------------------------
+// test address attribute validation
+// ----------------------------------------------------------------------------
+
+func TestBasicValidate_Read_addresses(test *testing.T) {
+	ctx := test.Context()
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	filename, moreCleanUp := createTempDataFile(test, testAddressData, "jsonl")
+	defer moreCleanUp()
+
+	validator := &validate.BasicValidate{
+		InputURL: "file://" + filename,
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "Line 1: ADDR_* has ADDR_CITY, ADDR_STATE but no ADDR_LINE1 or ADDR_FULL")
+	require.Contains(test, actual, "Line 2: ADDR_* has ADDR_POSTAL_CODE but no ADDR_LINE1 or ADDR_FULL")
+	require.Contains(test, actual, "Line 3: ADDR_* combines ADDR_FULL with ADDR_CITY")
+	require.Contains(test, actual, `Line 4: ADDRESSES[0].ADDR_COUNTRY is not an ISO 3166 country code or name: "Narnia"`)
+	require.Contains(test, actual, "Line 4: ADDRESSES[1].HOME_ADDR_* has HOME_ADDR_CITY but no ADDR_LINE1 or ADDR_FULL")
+	require.NotContains(test, actual, "WORK_ADDR")
+	require.NotContains(test, actual, "Line 5:")
+	require.NotContains(test, actual, "Line 6:")
+	require.NotContains(test, actual, "Line 7:")
+	require.NotContains(test, actual, "Line 8:")
+	require.Contains(test, actual, "3 record(s) had incomplete addresses")
+	require.Contains(test, actual, "1 record(s) had addresses combining ADDR_FULL with parsed address attributes")
+	require.Contains(test, actual, "1 record(s) had ADDR_COUNTRY values that are not ISO 3166 countries")
+	require.Contains(test, actual, "Validated 8 lines, 0 were bad")
+	require.True(test, result)
+}
@@ -0,0 +1,83 @@
+package validate
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Report string values holding control characters (e.g. NUL, tab), invisible
+// characters (e.g. zero-width spaces, bidirectional marks, non-breaking spaces)
+// or text not in Unicode Normalization Form C.  Such values look right to
+// people but do not match other values that look the same.
+func (validate *BasicValidate) checkCharacters(object jsonObject) []issue {
+	var issues []issue
+
+	walkJSON(object, "", func(path string, value any) {
+		str, isString := value.(string)
+		if !isString {
+			return
+		}
+
+		controlFound, invisibleFound := false, false
+
+		for index, char := range []rune(str) {
+			switch {
+			case !controlFound && unicode.IsControl(char):
+				controlFound = true
+
+				issues = append(issues, newWarning(3020, 3021, path, char, index+1))
+			case !invisibleFound && isInvisible(char):
+				invisibleFound = true
+
+				issues = append(issues, newWarning(3022, 3023, path, char, index+1))
+			}
+		}
+
+		if !norm.NFC.IsNormalString(str) {
+			issues = append(issues, newWarning(3024, 3025, path))
+		}
+	})
+
+	return issues
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Format characters, such as zero-width spaces and bidirectional marks, and
+// non-breaking spaces.
+func isInvisible(char rune) bool {
+	switch char {
+	case '\u00a0', '\u2007', '\u202f':
+		return true
+	default:
+		return unicode.Is(unicode.Cf, char)
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+// Replace white space, such as tabs and non-breaking spaces, with plain spaces,
+// remove other control and invisible characters, and convert the result to
+// Unicode Normalization Form C.
+func normalizeString(str string) string {
+	mapped := strings.Map(func(char rune) rune {
+		switch {
+		case unicode.IsSpace(char):
+			return ' '
+		case unicode.IsControl(char), isInvisible(char):
+			return -1
+		default:
+			return char
+		}
+	}, str)
+
+	return norm.NFC.String(mapped)
+}
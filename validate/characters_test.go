@@ -0,0 +1,117 @@
+//go:build !windows
+
+package validate_test
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/senzing-garage/validate/validate"
+	"github.com/stretchr/testify/require"
+)
+
+// Records whose string values hold a tab, a zero-width space, a decomposed
+// "é" and a non-breaking space, followed by a line that is not JSON.
+const testCharacterData = `{"DATA_SOURCE": "TEST", "RECORD_ID": "1", "NAMES": [{"NAME_FULL": "Bob\tSmith` + "\u200b" + `"}], "AMOUNT": 12.50}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "2", "NAME_LAST": "Cafe` + "\u0301" + `", "ADDR_FULL": "1` + "\u00a0" + `Main St <rear>"}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "3", "NAME_FULL": "Mary Jones"}
+not a record
+`
+
+// ----------------------------------------------------------------------------
+// test control and invisible character detection
+// ----------------------------------------------------------------------------
+
+func TestBasicValidate_Read_characters(test *testing.T) {
+	ctx := test.Context()
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	filename, moreCleanUp := createTempDataFile(test, testCharacterData, "jsonl")
+	defer moreCleanUp()
+
+	validator := &validate.BasicValidate{
+		InputURL: "file://" + filename,
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "Line 1: NAMES[0].NAME_FULL holds control character U+0009 at character 4")
+	require.Contains(test, actual, "Line 1: NAMES[0].NAME_FULL holds invisible or non-breaking character U+200B at character 10")
+	require.Contains(test, actual, "Line 2: NAME_LAST is not in Unicode Normalization Form C (NFC)")
+	require.Contains(test, actual, "Line 2: ADDR_FULL holds invisible or non-breaking character U+00A0 at character 2")
+	require.NotContains(test, actual, "Line 3:")
+	require.Contains(test, actual, "1 record(s) had control characters in attribute values")
+	require.Contains(test, actual, "2 record(s) had invisible or non-breaking characters in attribute values")
+	require.Contains(test, actual, "1 record(s) had attribute values not in Unicode Normalization Form C (NFC)")
+	require.Contains(test, actual, "Validated 4 lines, 2 were bad")
+	require.True(test, result)
+}
+
+// records are written with their string values normalized, attributes and
+// numbers as read, and lines that are not records unchanged.
+func TestBasicValidate_Read_normalized_output(test *testing.T) {
+	ctx := test.Context()
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	filename, moreCleanUp := createTempDataFile(test, testCharacterData, "jsonl")
+	defer moreCleanUp()
+
+	outputFile := filepath.Join(test.TempDir(), "normalized.jsonl")
+
+	validator := &validate.BasicValidate{
+		InputURL:             "file://" + filename,
+		NormalizedOutputFile: outputFile,
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	require.Contains(test, string(out), "Writing normalized records to "+outputFile)
+	require.True(test, result)
+
+	normalized, err := os.ReadFile(outputFile)
+	require.NoError(test, err)
+
+	expected := `{"DATA_SOURCE":"TEST","RECORD_ID":"1","NAMES":[{"NAME_FULL":"Bob Smith"}],"AMOUNT":12.50}
+{"DATA_SOURCE":"TEST","RECORD_ID":"2","NAME_LAST":"Café","ADDR_FULL":"1 Main St <rear>"}
+{"DATA_SOURCE":"TEST","RECORD_ID":"3","NAME_FULL":"Mary Jones"}
+not a record
+`
+	require.Equal(test, expected, string(normalized))
+}
+
+func TestBasicValidate_Read_normalized_output_cannot_create(test *testing.T) {
+	ctx := test.Context()
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	filename, moreCleanUp := createTempDataFile(test, testCharacterData, "jsonl")
+	defer moreCleanUp()
+
+	validator := &validate.BasicValidate{
+		InputURL:             "file://" + filename,
+		NormalizedOutputFile: filepath.Join(test.TempDir(), "missing", "normalized.jsonl"),
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "Fatal error creating output file")
+	require.NotContains(test, actual, "Validated")
+	require.False(test, result)
+}
@@ -0,0 +1,101 @@
+package validate
+
+import (
+	_ "embed" // Bundles the ISO 3166 country list.
+	"encoding/csv"
+	"fmt"
+	"strings"
+	"sync"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// ISO 3166-1 countries: alpha-2, alpha-3 and numeric codes, and English names
+// separated by "|".
+//
+//go:embed iso3166.csv
+var iso3166CSV string
+
+// Country alpha-2 codes keyed by every code and normalized name of the country.
+var (
+	countries     map[string]string
+	countriesOnce sync.Once
+)
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Return the ISO 3166-1 alpha-2 code of a country given by its alpha-2, alpha-3
+// or numeric code or by its English name, ignoring case, accents and
+// punctuation.
+func lookupCountry(value string) (string, bool) {
+	countriesOnce.Do(loadCountries)
+
+	code, isFound := countries[normalizeCountry(value)]
+
+	return code, isFound
+}
+
+// ----------------------------------------------------------------------------
+
+func loadCountries() {
+	rows, err := csv.NewReader(strings.NewReader(iso3166CSV)).ReadAll()
+	if err != nil {
+		panic(fmt.Sprintf("iso3166.csv: %v", err))
+	}
+
+	countries = map[string]string{}
+
+	for _, row := range rows[1:] {
+		alpha2 := row[0]
+		countries[alpha2] = alpha2
+		countries[row[1]] = alpha2
+		countries[row[2]] = alpha2
+
+		for name := range strings.SplitSeq(row[3], "|") {
+			countries[normalizeCountry(name)] = alpha2
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+// Upper case the value, pad numeric codes to three digits and reduce names to
+// words without accents, punctuation, "THE" or abbreviations of "SAINT".
+func normalizeCountry(value string) string {
+	value = strings.ToUpper(strings.TrimSpace(value))
+
+	if len(value) > 0 && len(value) < 3 && strings.Trim(value, "0123456789") == "" {
+		return fmt.Sprintf("%03s", value)
+	}
+
+	var builder strings.Builder
+
+	for _, char := range norm.NFD.String(value) {
+		switch {
+		case unicode.Is(unicode.Mn, char):
+		case char == '&':
+			builder.WriteString(" AND ")
+		case unicode.IsLetter(char), unicode.IsDigit(char):
+			builder.WriteRune(char)
+		default:
+			builder.WriteRune(' ')
+		}
+	}
+
+	words := []string{}
+
+	for _, word := range strings.Fields(builder.String()) {
+		switch word {
+		case "THE":
+		case "ST":
+			words = append(words, "SAINT")
+		default:
+			words = append(words, word)
+		}
+	}
+
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,179 @@
+package validate
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// Precision of a parsed date, so partial dates such as "1980-05" are compared
+// only as far as they go.
+const (
+	yearPrecision = iota
+	monthPrecision
+	dayPrecision
+)
+
+// Layouts, in order of preference, accepted for date attributes.  Where a date
+// could be read as month/day or day/month, month/day is preferred.
+var dateLayouts = []struct {
+	layout    string
+	precision int
+}{
+	{time.RFC3339, dayPrecision},
+	{"2006-01-02T15:04:05", dayPrecision},
+	{"2006-01-02 15:04:05", dayPrecision},
+	{"2006-1-2", dayPrecision},
+	{"2006/1/2", dayPrecision},
+	{"2006.1.2", dayPrecision},
+	{"20060102", dayPrecision},
+	{"1/2/2006", dayPrecision},
+	{"2/1/2006", dayPrecision},
+	{"1-2-2006", dayPrecision},
+	{"2-1-2006", dayPrecision},
+	{"2.1.2006", dayPrecision},
+	{"2006-Jan-2", dayPrecision},
+	{"2-Jan-2006", dayPrecision},
+	{"2 Jan 2006", dayPrecision},
+	{"2 January 2006", dayPrecision},
+	{"Jan 2 2006", dayPrecision},
+	{"Jan 2, 2006", dayPrecision},
+	{"January 2 2006", dayPrecision},
+	{"January 2, 2006", dayPrecision},
+	{"2006-1", monthPrecision},
+	{"2006/1", monthPrecision},
+	{"1/2006", monthPrecision},
+	{"1-2006", monthPrecision},
+	{"Jan 2006", monthPrecision},
+	{"January 2006", monthPrecision},
+	{"2006", yearPrecision},
+}
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+type parsedDate struct {
+	path      string
+	precision int
+	time      time.Time
+	value     string
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Report date attributes, anywhere in the record, that are impossible (e.g.
+// 31/02/1980), in an unrecognized format, a date of birth in the future or a
+// date of death before a date of birth.
+func (validate *BasicValidate) checkDates(object jsonObject) []issue {
+	var (
+		births []parsedDate
+		deaths []parsedDate
+		issues []issue
+	)
+
+	walkJSON(object, "", func(path string, value any) {
+		str, isString := value.(string)
+		attribute := attributeName(path)
+
+		if !isString || !isDateAttribute(attribute) || strings.TrimSpace(str) == "" {
+			return
+		}
+
+		date, err := parseDate(path, str)
+
+		switch {
+		case errors.Is(err, errImpossibleDate):
+			issues = append(issues, newError(3030, 3031, path, str))
+		case err != nil:
+			issues = append(issues, newWarning(3032, 3033, path, str))
+		case attribute == "DATE_OF_BIRTH":
+			if date.time.After(time.Now()) {
+				issues = append(issues, newError(3034, 3035, path, str))
+			}
+
+			births = append(births, date)
+		case attribute == "DATE_OF_DEATH":
+			deaths = append(deaths, date)
+		}
+	})
+
+	for _, death := range deaths {
+		for _, birth := range births {
+			if isBefore(death, birth) {
+				issues = append(issues, newError(3036, 3037, death.path, death.value, birth.path, birth.value))
+			}
+		}
+	}
+
+	return issues
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// The attribute name at the end of a path, e.g. "DATE_OF_BIRTH" for
+// "DATES[0].DATE_OF_BIRTH", or "" for a list element.
+func attributeName(path string) string {
+	if strings.HasSuffix(path, "]") {
+		return ""
+	}
+
+	return path[strings.LastIndex(path, ".")+1:]
+}
+
+// ----------------------------------------------------------------------------
+
+// Compare two dates only as precisely as the less precise of them.
+func isBefore(date parsedDate, other parsedDate) bool {
+	precision := min(date.precision, other.precision)
+
+	return truncateDate(date.time, precision).Before(truncateDate(other.time, precision))
+}
+
+// ----------------------------------------------------------------------------
+
+// Date attributes of the Generic Entity Specification, such as DATE_OF_BIRTH
+// and REGISTRATION_DATE, and other attributes named as dates.
+func isDateAttribute(attribute string) bool {
+	return strings.HasPrefix(attribute, "DATE_") || strings.HasSuffix(attribute, "_DATE")
+}
+
+// ----------------------------------------------------------------------------
+
+// Parse a date in any accepted layout.  errImpossibleDate is returned if the
+// value is only readable as a date that does not exist.
+func parseDate(path string, value string) (parsedDate, error) {
+	value = strings.TrimSpace(value)
+	err := errUnrecognizedDate
+
+	for _, dateLayout := range dateLayouts {
+		parsed, parseErr := time.Parse(dateLayout.layout, value)
+		if parseErr == nil {
+			return parsedDate{path: path, precision: dateLayout.precision, time: parsed, value: value}, nil
+		}
+
+		var timeErr *time.ParseError
+		if errors.As(parseErr, &timeErr) && strings.HasSuffix(timeErr.Message, "out of range") {
+			err = errImpossibleDate
+		}
+	}
+
+	return parsedDate{path: path, precision: yearPrecision, time: time.Time{}, value: value}, err
+}
+
+// ----------------------------------------------------------------------------
+
+func truncateDate(date time.Time, precision int) time.Time {
+	switch precision {
+	case yearPrecision:
+		return time.Date(date.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	case monthPrecision:
+		return time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	}
+}
@@ -0,0 +1,60 @@
+//go:build !windows
+
+package validate_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/senzing-garage/validate/validate"
+	"github.com/stretchr/testify/require"
+)
+
+const testDateData = `{"DATA_SOURCE": "TEST", "RECORD_ID": "1", "DATE_OF_BIRTH": "31/02/1980"}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "2", "DATE_OF_BIRTH": "19800231"}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "3", "DATE_OF_BIRTH": "2999-01-01"}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "4", "DATES": [{"DATE_OF_BIRTH": "1980-05"}, {"DATE_OF_DEATH": "1979"}]}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "5", "DATE_OF_BIRTH": "16/7/1974", "DATE_OF_DEATH": "7 FEB 2001"}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "6", "DATE_OF_BIRTH": "1980-05", "DATE_OF_DEATH": "1980"}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "7", "REGISTRATION_DATE": "2020-01-02T03:04:05Z"}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "8", "REGISTRATION_DATE": "sometime"}
+`
+
+// ----------------------------------------------------------------------------
+// test date attribute validation
+// ----------------------------------------------------------------------------
+
+func TestBasicValidate_Read_dates(test *testing.T) {
+	ctx := test.Context()
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	filename, moreCleanUp := createTempDataFile(test, testDateData, "jsonl")
+	defer moreCleanUp()
+
+	validator := &validate.BasicValidate{
+		InputURL: "file://" + filename,
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, `Line 1: DATE_OF_BIRTH is an impossible date: "31/02/1980"`)
+	require.Contains(test, actual, `Line 2: DATE_OF_BIRTH is an impossible date: "19800231"`)
+	require.Contains(test, actual, `Line 3: DATE_OF_BIRTH is in the future: "2999-01-01"`)
+	require.Contains(test, actual, `Line 4: DATES[1].DATE_OF_DEATH "1979" is before DATES[0].DATE_OF_BIRTH "1980-05"`)
+	require.NotContains(test, actual, "Line 5:")
+	require.NotContains(test, actual, "Line 6:")
+	require.NotContains(test, actual, "Line 7:")
+	require.Contains(test, actual, `Line 8: REGISTRATION_DATE is a date in an unrecognized format: "sometime"`)
+	require.Contains(test, actual, "2 record(s) had impossible dates")
+	require.Contains(test, actual, "1 record(s) had dates in an unrecognized format")
+	require.Contains(test, actual, "1 record(s) had a date of birth in the future")
+	require.Contains(test, actual, "1 record(s) had a date of death before the date of birth")
+	require.Contains(test, actual, "Validated 8 lines, 4 were bad")
+	require.True(test, result)
+}
@@ -0,0 +1,102 @@
+package validate
+
+import (
+	"net/mail"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Email address attributes, optionally prefixed by a usage type such as "WORK_".
+var emailAttribute = regexp.MustCompile(`^(.*_)?EMAIL_ADDRESS$`)
+
+// Local parts of email addresses, without punctuation, entered when the address
+// is not known or should not be used.
+var placeholderEmailUsers = []string{
+	"DONOTREPLY",
+	"DONTREPLY",
+	"NOEMAIL",
+	"NOMAIL",
+	"NONE",
+	"NOREPLY",
+	"NULL",
+	"TEST",
+	"UNKNOWN",
+}
+
+// Domains of email addresses entered when the address is not known, and
+// domains reserved for examples and testing.
+var placeholderEmailDomains = []string{
+	"EMAIL.COM",
+	"EXAMPLE",
+	"EXAMPLE.COM",
+	"EXAMPLE.NET",
+	"EXAMPLE.ORG",
+	"INVALID",
+	"LOCALHOST",
+	"NOEMAIL.COM",
+	"NONE.COM",
+	"TEST",
+	"TEST.COM",
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Report email addresses that are not a single address in the form
+// user@domain.tld, and addresses such as noreply@ or test@test.com that are
+// placeholders.
+func (validate *BasicValidate) checkEmails(object jsonObject) []issue {
+	var issues []issue
+
+	walkJSON(object, "", func(path string, value any) {
+		email, isString := value.(string)
+		if !isString || !emailAttribute.MatchString(attributeName(path)) || strings.TrimSpace(email) == "" {
+			return
+		}
+
+		switch {
+		case !isEmailAddress(email):
+			issues = append(issues, newWarning(3074, 3075, path, email))
+		case isPlaceholderEmail(email):
+			issues = append(issues, newWarning(3076, 3077, path, email))
+		}
+	})
+
+	return issues
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// An email address is valid if it is only an RFC 5322 address, without a
+// display name or comments, with a domain of at least two labels.
+func isEmailAddress(email string) bool {
+	email = strings.TrimSpace(email)
+
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Name != "" || address.Address != email {
+		return false
+	}
+
+	domain := email[strings.LastIndex(email, "@")+1:]
+
+	return strings.Contains(strings.Trim(domain, "."), ".")
+}
+
+// ----------------------------------------------------------------------------
+
+func isPlaceholderEmail(email string) bool {
+	email = strings.ToUpper(strings.TrimSpace(email))
+	at := strings.LastIndex(email, "@")
+	user := normalizeIdentifier(email[:at])
+	domain := email[at+1:]
+
+	return slices.Contains(placeholderEmailUsers, user) ||
+		slices.Contains(placeholderEmailDomains, domain) ||
+		strings.HasSuffix(domain, ".EXAMPLE") ||
+		strings.HasSuffix(domain, ".INVALID") ||
+		strings.HasSuffix(domain, ".TEST")
+}
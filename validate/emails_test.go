@@ -0,0 +1,54 @@
+//go:build !windows
+
+package validate_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/senzing-garage/validate/validate"
+	"github.com/stretchr/testify/require"
+)
+
+const testEmailData = `{"DATA_SOURCE": "TEST", "RECORD_ID": "1", "EMAIL_ADDRESS": "bob@@acme.com"}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "2", "EMAIL_ADDRESS": "Bob <bob@acme.com>"}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "3", "EMAIL_ADDRESS": "bob@localhost"}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "4", "EMAIL_ADDRESS": "no-reply@acme.com"}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "5", "CONTACTS": [{"WORK_EMAIL_ADDRESS": "Test@Test.com"}]}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "6", "EMAIL_ADDRESS": "bob.smith+news@acme.co.uk"}
+`
+
+// ----------------------------------------------------------------------------
+// test email address validation
+// ----------------------------------------------------------------------------
+
+func TestBasicValidate_Read_emails(test *testing.T) {
+	ctx := test.Context()
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	filename, moreCleanUp := createTempDataFile(test, testEmailData, "jsonl")
+	defer moreCleanUp()
+
+	validator := &validate.BasicValidate{
+		InputURL: "file://" + filename,
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, `Line 1: EMAIL_ADDRESS is not a valid email address: "bob@@acme.com"`)
+	require.Contains(test, actual, `Line 2: EMAIL_ADDRESS is not a valid email address: "Bob <bob@acme.com>"`)
+	require.Contains(test, actual, `Line 3: EMAIL_ADDRESS is not a valid email address: "bob@localhost"`)
+	require.Contains(test, actual, `Line 4: EMAIL_ADDRESS is a placeholder email address: "no-reply@acme.com"`)
+	require.Contains(test, actual, `Line 5: CONTACTS[0].WORK_EMAIL_ADDRESS is a placeholder email address: "Test@Test.com"`)
+	require.NotContains(test, actual, "Line 6:")
+	require.Contains(test, actual, "3 record(s) had invalid email addresses")
+	require.Contains(test, actual, "2 record(s) had placeholder email addresses")
+	require.Contains(test, actual, "Validated 6 lines, 0 were bad")
+	require.True(test, result)
+}
@@ -0,0 +1,67 @@
+package validate
+
+import (
+	"regexp"
+	"strings"
+)
+
+// The indexes ending the path of a list element, e.g. "[0]" in "NAMES[0]".
+var listIndexes = regexp.MustCompile(`(\[\d+\])+$`)
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Report values, anywhere in the record, that are null, an empty string, only
+// white space, an empty list or an empty object.  They are warnings, or errors
+// if EmptyValuesAsErrors is set, and are summarized for each attribute.
+func (validate *BasicValidate) checkEmptyValues(object jsonObject) []issue {
+	var issues []issue
+
+	newIssue := newWarning
+	if validate.EmptyValuesAsErrors {
+		newIssue = newError
+	}
+
+	walkJSON(object, "", func(path string, value any) {
+		description := describeEmptyValue(value)
+		if path == "" || description == "" {
+			return
+		}
+
+		emptyIssue := newIssue(3090, 3091, path, description)
+		emptyIssue.summaryDetail = attributeName(listIndexes.ReplaceAllString(path, ""))
+		issues = append(issues, emptyIssue)
+	})
+
+	return issues
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Describe an empty value, or return "" if the value is not empty.
+func describeEmptyValue(value any) string {
+	switch typedValue := value.(type) {
+	case nil:
+		return "null"
+	case string:
+		switch {
+		case typedValue == "":
+			return "an empty string"
+		case strings.TrimSpace(typedValue) == "":
+			return "only white space"
+		}
+	case []any:
+		if len(typedValue) == 0 {
+			return "an empty list"
+		}
+	case jsonObject:
+		if len(typedValue) == 0 {
+			return "an empty object"
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,78 @@
+//go:build !windows
+
+package validate_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/senzing-garage/validate/validate"
+	"github.com/stretchr/testify/require"
+)
+
+const testEmptyData = `{"DATA_SOURCE": "TEST", "RECORD_ID": "1", "NAME_FULL": ""}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "2", "PHONE_NUMBER": null, "NAME_FULL": " \t"}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "3", "NAMES": [], "ADDRESSES": [{}], "EXTRA": {}}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "4", "NAMES": [{"NAME_FULL": ""}, {"NAME_FULL": "Bob Smith"}]}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "5", "NAME_FULL": "Bob Smith"}
+`
+
+// ----------------------------------------------------------------------------
+// test empty value detection
+// ----------------------------------------------------------------------------
+
+func TestBasicValidate_Read_empty_values(test *testing.T) {
+	actual, result := readEmptyValues(test, false)
+
+	require.Contains(test, actual, "Line 1: NAME_FULL is an empty string")
+	require.Contains(test, actual, "Line 2: PHONE_NUMBER is null")
+	require.Contains(test, actual, "Line 2: NAME_FULL is only white space")
+	require.Contains(test, actual, "Line 3: NAMES is an empty list")
+	require.Contains(test, actual, "Line 3: ADDRESSES[0] is an empty object")
+	require.Contains(test, actual, "Line 3: EXTRA is an empty object")
+	require.Contains(test, actual, "Line 4: NAMES[0].NAME_FULL is an empty string")
+	require.NotContains(test, actual, "Line 5:")
+	require.Contains(test, actual, "1 record(s) had empty ADDRESSES values")
+	require.Contains(test, actual, "1 record(s) had empty EXTRA values")
+	require.Contains(test, actual, "3 record(s) had empty NAME_FULL values")
+	require.Contains(test, actual, "1 record(s) had empty NAMES values")
+	require.Contains(test, actual, "1 record(s) had empty PHONE_NUMBER values")
+	require.Contains(test, actual, "Validated 5 lines, 1 were bad")
+	require.True(test, result)
+}
+
+// EmptyValuesAsErrors counts records with empty values as bad.
+func TestBasicValidate_Read_empty_values_as_errors(test *testing.T) {
+	actual, result := readEmptyValues(test, true)
+
+	require.Contains(test, actual, "Line 1: NAME_FULL is an empty string")
+	require.Contains(test, actual, "Validated 5 lines, 4 were bad")
+	require.True(test, result)
+}
+
+// ----------------------------------------------------------------------------
+// Helper functions
+// ----------------------------------------------------------------------------
+
+// read testEmptyData and return the output and result.
+func readEmptyValues(t *testing.T, emptyValuesAsErrors bool) (string, bool) {
+	t.Helper()
+
+	reader, writer, cleanUp := mockStdout(t)
+	defer cleanUp()
+
+	filename, moreCleanUp := createTempDataFile(t, testEmptyData, "jsonl")
+	defer moreCleanUp()
+
+	validator := &validate.BasicValidate{
+		EmptyValuesAsErrors: emptyValuesAsErrors,
+		InputURL:            "file://" + filename,
+	}
+	result := validator.Read(t.Context())
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+
+	return string(out), result
+}
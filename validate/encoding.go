@@ -0,0 +1,132 @@
+package validate
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"unicode/utf8"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/ianaindex"
+	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/transform"
+)
+
+var (
+	utf8BOM    = []byte{0xEF, 0xBB, 0xBF}
+	utf16BEBOM = []byte{0xFE, 0xFF}
+	utf16LEBOM = []byte{0xFF, 0xFE}
+)
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Wrap the reader to transcode the input to UTF-8.  A byte order mark is
+// honored, and removed, whatever InputEncoding says.  Otherwise the input is
+// decoded using InputEncoding, an IANA name such as "UTF-16LE" or
+// "windows-1252".  UTF-8 input is passed through unchanged so that invalid byte
+// sequences can be reported rather than silently replaced.
+func (validate *BasicValidate) decodeInput(reader io.Reader) (io.Reader, error) {
+	inputEncoding := validate.inputEncoding()
+	if inputEncoding == nil {
+		return nil, wraperror.Errorf(errForPackage, "unsupported input encoding: %s", validate.InputEncoding)
+	}
+
+	bufferedReader := bufio.NewReader(reader)
+	bom := detectBOM(bufferedReader)
+
+	if bom != "" {
+		validate.log(2240, bom)
+	}
+
+	validate.fixBOM(bom)
+
+	switch {
+	case bom == "UTF-8":
+		_, err := bufferedReader.Discard(len(utf8BOM))
+		if err != nil {
+			return nil, wraperror.Errorf(err, "discarding byte order mark")
+		}
+
+		return bufferedReader, nil
+	case bom == "" && inputEncoding == unicode.UTF8:
+		return bufferedReader, nil
+	default:
+		return transform.NewReader(bufferedReader, unicode.BOMOverride(inputEncoding.NewDecoder())), nil
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+// The encoding InputEncoding names, UTF-8 if it is not set, or nil if it is not
+// supported.
+func (validate *BasicValidate) inputEncoding() encoding.Encoding {
+	if validate.InputEncoding == "" {
+		return unicode.UTF8
+	}
+
+	namedEncoding, err := ianaindex.IANA.Encoding(validate.InputEncoding)
+	if err != nil {
+		return nil
+	}
+
+	return namedEncoding
+}
+
+// ----------------------------------------------------------------------------
+
+// Check that InputEncoding, if set, is an encoding the input can be decoded
+// from.
+func (validate *BasicValidate) isInputEncodingSupported() bool {
+	if validate.inputEncoding() != nil {
+		return true
+	}
+
+	validate.log(5029, validate.InputEncoding)
+
+	return false
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Return the encoding named by the byte order mark at the start of the reader,
+// or "" if there is none, without consuming any input.
+func detectBOM(reader *bufio.Reader) string {
+	peeked, _ := reader.Peek(len(utf8BOM))
+
+	switch {
+	case bytes.HasPrefix(peeked, utf8BOM):
+		return "UTF-8"
+	case bytes.HasPrefix(peeked, utf16BEBOM):
+		return "UTF-16BE"
+	case bytes.HasPrefix(peeked, utf16LEBOM):
+		return "UTF-16LE"
+	default:
+		return ""
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+// Return the byte offset of the first invalid UTF-8 byte sequence in str, or
+// -1 if str is valid UTF-8.
+func invalidUTF8Offset(str string) int {
+	if utf8.ValidString(str) {
+		return -1
+	}
+
+	for offset, char := range str {
+		if char == utf8.RuneError {
+			_, size := utf8.DecodeRuneInString(str[offset:])
+			if size == 1 {
+				return offset
+			}
+		}
+	}
+
+	return -1
+}
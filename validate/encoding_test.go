@@ -0,0 +1,162 @@
+//go:build !windows
+
+package validate_test
+
+import (
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/senzing-garage/validate/validate"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/unicode"
+)
+
+// A good record holding a character outside of ASCII.
+const testLatinRecord = `{"DATA_SOURCE": "TEST", "RECORD_ID": "1", "NAME_FULL": "José Müller"}` + "\n"
+
+// ----------------------------------------------------------------------------
+// test Read with byte order marks and input encodings
+// ----------------------------------------------------------------------------
+
+func TestBasicValidate_Read_utf16le_bom(test *testing.T) {
+	actual, result := readEncoded(test, unicode.UTF16(unicode.LittleEndian, unicode.UseBOM), testGoodData, "")
+
+	require.Contains(test, actual, "Detected a UTF-16LE byte order mark")
+	require.Contains(test, actual, expected12good)
+	require.True(test, result)
+}
+
+func TestBasicValidate_Read_utf16be_bom(test *testing.T) {
+	actual, result := readEncoded(test, unicode.UTF16(unicode.BigEndian, unicode.UseBOM), testBadData, "")
+
+	require.Contains(test, actual, "Detected a UTF-16BE byte order mark")
+	require.Contains(test, actual, expected16good4bad)
+	require.True(test, result)
+}
+
+func TestBasicValidate_Read_utf8_bom(test *testing.T) {
+	actual, result := readEncoded(test, unicode.UTF8BOM, testGoodData, "")
+
+	require.Contains(test, actual, "Detected a UTF-8 byte order mark")
+	require.Contains(test, actual, expected12good)
+	require.True(test, result)
+}
+
+// a JSON array is recognized once the input is transcoded.
+func TestBasicValidate_Read_utf16le_bom_json_array(test *testing.T) {
+	encoder := unicode.UTF16(unicode.LittleEndian, unicode.UseBOM)
+	actual, result := readEncoded(test, encoder, jsonArrayOf(testGoodData), "")
+
+	require.Contains(test, actual, "Input starts with a JSON array")
+	require.Contains(test, actual, expected12goodJSON)
+	require.True(test, result)
+}
+
+func TestBasicValidate_Read_utf16le_no_bom(test *testing.T) {
+	encoder := unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM)
+	actual, result := readEncoded(test, encoder, testGoodData, "UTF-16LE")
+
+	require.NotContains(test, actual, "byte order mark")
+	require.Contains(test, actual, expected12good)
+	require.True(test, result)
+}
+
+func TestBasicValidate_Read_windows1252(test *testing.T) {
+	actual, result := readEncoded(test, charmap.Windows1252, testLatinRecord, "windows-1252")
+
+	require.Contains(test, actual, "Validated 1 lines, 0 were bad")
+	require.True(test, result)
+}
+
+// Windows-1252 input read as UTF-8 is reported as invalid UTF-8, with the offset of the first bad byte.
+func TestBasicValidate_Read_invalid_utf8(test *testing.T) {
+	actual, result := readEncoded(test, charmap.Windows1252, testLatinRecord, "")
+
+	offset := strings.Index(testLatinRecord, "é")
+	require.Contains(test, actual, "Line 1: invalid UTF-8 byte sequence at byte offset "+strconv.Itoa(offset))
+	require.Contains(test, actual, "1 record(s) had invalid UTF-8 byte sequences")
+	require.Contains(test, actual, "Validated 1 lines, 1 were bad")
+	require.NotContains(test, actual, "not well formed")
+	require.True(test, result)
+}
+
+// Invalid UTF-8 is reported even though transforming or repairing the record
+// replaces it.
+func TestBasicValidate_Read_invalid_utf8_transformed(test *testing.T) {
+	content := `{"RECORD_ID": "1", "NAME": "Bob` + "\xff" + `"}` + "\n"
+
+	filename, cleanUpFile := createTempDataFile(test, content, "jsonl")
+	defer cleanUpFile()
+
+	for _, validator := range []*validate.BasicValidate{
+		{DefaultDataSource: "TEST", RenameAttributes: []string{"NAME=NAME_FULL"}},
+		{DefaultDataSource: "TEST", FixOutputFile: filepath.Join(test.TempDir(), "fixed.jsonl")},
+	} {
+		reader, writer, cleanUp := mockStdout(test)
+
+		validator.InputURL = "file://" + filename
+		if validator.FixOutputFile == "" {
+			validator.Read(test.Context())
+		} else {
+			validator.Fix(test.Context())
+		}
+
+		writer.Close()
+		cleanUp()
+
+		out, _ := io.ReadAll(reader)
+		actual := string(out)
+
+		require.Contains(test, actual, "Line 1: invalid UTF-8 byte sequence at byte offset 31")
+		require.Contains(test, actual, "Validated 1 lines, 1 were bad")
+	}
+}
+
+func TestBasicValidate_Read_unsupported_encoding(test *testing.T) {
+	actual, result := readEncoded(test, unicode.UTF8, testGoodData, "EBCDIC-NOT-AN-ENCODING")
+
+	require.Contains(test, actual, "Fatal error unsupported input-encoding: EBCDIC-NOT-AN-ENCODING")
+	require.NotContains(test, actual, "Validating")
+	require.False(test, result)
+}
+
+// ----------------------------------------------------------------------------
+// Helper functions
+// ----------------------------------------------------------------------------
+
+// write the content to a .jsonl file using the given encoding, read it with
+// the given input encoding and return the output and the result.
+func readEncoded(
+	t *testing.T,
+	fileEncoding encoding.Encoding,
+	content string,
+	inputEncoding string,
+) (string, bool) {
+	t.Helper()
+
+	encoded, err := fileEncoding.NewEncoder().String(content)
+	require.NoError(t, err)
+
+	filename, cleanUpFile := createTempDataFile(t, encoded, "jsonl")
+	defer cleanUpFile()
+
+	reader, writer, cleanUp := mockStdout(t)
+	defer cleanUp()
+
+	validator := &validate.BasicValidate{
+		InputEncoding: inputEncoding,
+		InputURL:      "file://" + filename,
+	}
+	result := validator.Read(t.Context())
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+
+	return string(out), result
+}
@@ -0,0 +1,236 @@
+package validate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Repairs applied by Fix, in the order they are applied, when Repairs is not
+// set.
+var fixRepairs = []string{"bom", "crlf", "key-case", "numeric-record-id", "whitespace"}
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// recordFix logs and counts the changes made repairing or transforming one
+// record.
+type recordFix struct {
+	changes  int
+	location string
+	validate *BasicValidate
+}
+
+// ----------------------------------------------------------------------------
+// Public methods
+// ----------------------------------------------------------------------------
+
+// Fix reads and validates the input as Read does, writing every record, with
+// the selected Repairs applied, to FixOutputFile as JSONL.  Each change made is
+// logged with the location of the record.  Records are validated as repaired.
+// Head, Skip and SampleRate cannot be set, as every record is written.
+func (validate *BasicValidate) Fix(ctx context.Context) bool {
+	if validate.FixOutputFile == "" {
+		validate.log(5034)
+
+		return false
+	}
+
+	if validate.Head != 0 || validate.Skip != 0 || validate.SampleRate != 0 {
+		validate.log(5039)
+
+		return false
+	}
+
+	return validate.Read(ctx)
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Check that every one of Repairs is a known repair.
+func (validate *BasicValidate) areRepairsSupported() bool {
+	for _, repair := range validate.Repairs {
+		if !slices.Contains(fixRepairs, repair) {
+			validate.log(5033, repair, strings.Join(fixRepairs, ", "))
+
+			return false
+		}
+	}
+
+	return true
+}
+
+// ----------------------------------------------------------------------------
+
+// Note the byte order mark the input started with, if any.  When fixing, the
+// bom repair logs it as removed, and otherwise FixOutputFile starts with the
+// byte order mark of UTF-8, which it is written in.
+func (validate *BasicValidate) fixBOM(bom string) {
+	if validate.fixOutput == nil || bom == "" {
+		return
+	}
+
+	if validate.isRepairSelected("bom") {
+		validate.log(2276, bom)
+	} else {
+		validate.fixOutput.write(utf8BOM)
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+// Note whether the line about to be validated ended with CRLF.  When fixing,
+// the crlf repair logs it as replaced, and otherwise FixOutputFile keeps it.
+func (validate *BasicValidate) fixLineEnding(location string, isCRLF bool) {
+	if validate.fixOutput == nil {
+		return
+	}
+
+	validate.fixOutput.isCRLF = isCRLF && !validate.isRepairSelected("crlf")
+
+	if isCRLF && validate.isRepairSelected("crlf") {
+		validate.log(2275, location)
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+func (validate *BasicValidate) isRepairSelected(repair string) bool {
+	return len(validate.Repairs) == 0 || slices.Contains(validate.Repairs, repair)
+}
+
+// ----------------------------------------------------------------------------
+
+// Apply the selected repairs to a record when fixing, logging each change.
+// Returns the record unchanged if nothing was repaired or it is not a JSON
+// object.
+func (validate *BasicValidate) repairRecord(location string, str string) string {
+	if validate.fixOutput == nil {
+		return str
+	}
+
+	object, err := parseJSONObject(str)
+	if err != nil {
+		return str
+	}
+
+	fix := &recordFix{changes: 0, location: location, validate: validate}
+
+	if validate.isRepairSelected("key-case") {
+		fix.upperCaseKeys(object, "")
+	}
+
+	if validate.isRepairSelected("numeric-record-id") {
+		fix.stringifyRecordID(object)
+	}
+
+	if validate.isRepairSelected("whitespace") {
+		fix.trimValues(object, "")
+	}
+
+	if fix.changes == 0 {
+		return str
+	}
+
+	repaired, err := marshalJSON(object)
+	if err != nil {
+		return str
+	}
+
+	return string(repaired)
+}
+
+// ----------------------------------------------------------------------------
+
+// Write a record to FixOutputFile, as a line of JSONL.
+func (validate *BasicValidate) writeFixed(str string) {
+	if validate.fixOutput != nil {
+		validate.fixOutput.writeLine([]byte(str))
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Methods for recordFix
+// ----------------------------------------------------------------------------
+
+func (fix *recordFix) change(messageID int, details ...any) {
+	fix.validate.log(messageID, append([]any{fix.location}, details...)...)
+	fix.changes++
+}
+
+// ----------------------------------------------------------------------------
+
+// Convert a numeric RECORD_ID to a string.
+func (fix *recordFix) stringifyRecordID(object jsonObject) {
+	for index, member := range object {
+		if number, isNumber := member.Value.(json.Number); isNumber && member.Key == "RECORD_ID" {
+			object[index].Value = number.String()
+			fix.change(2273, number)
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+// Trim white space from every string value in the value.
+func (fix *recordFix) trimValues(value any, path string) {
+	switch typedValue := value.(type) {
+	case jsonObject:
+		for index, member := range typedValue {
+			memberPath := joinJSONPath(path, member.Key)
+			if str, isString := member.Value.(string); isString {
+				typedValue[index].Value = fix.trimValue(memberPath, str)
+			} else {
+				fix.trimValues(member.Value, memberPath)
+			}
+		}
+	case []any:
+		for index, element := range typedValue {
+			elementPath := fmt.Sprintf("%s[%d]", path, index)
+			if str, isString := element.(string); isString {
+				typedValue[index] = fix.trimValue(elementPath, str)
+			} else {
+				fix.trimValues(element, elementPath)
+			}
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+func (fix *recordFix) trimValue(path string, str string) string {
+	trimmed := strings.TrimSpace(str)
+	if trimmed != str {
+		fix.change(2271, path)
+	}
+
+	return trimmed
+}
+
+// ----------------------------------------------------------------------------
+
+// Upper case, and trim white space from, every key in the value, unless the
+// object already has the upper case key.
+func (fix *recordFix) upperCaseKeys(value any, path string) {
+	switch typedValue := value.(type) {
+	case jsonObject:
+		for index, member := range typedValue {
+			key := canonicalKey(member.Key)
+			if _, isFound := typedValue.get(key); key != member.Key && !isFound {
+				typedValue[index].Key = key
+				fix.change(2272, joinJSONPath(path, member.Key), key)
+			}
+
+			fix.upperCaseKeys(member.Value, joinJSONPath(path, typedValue[index].Key))
+		}
+	case []any:
+		for index, element := range typedValue {
+			fix.upperCaseKeys(element, fmt.Sprintf("%s[%d]", path, index))
+		}
+	}
+}
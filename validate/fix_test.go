@@ -0,0 +1,139 @@
+//go:build !windows
+
+package validate_test
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/senzing-garage/validate/validate"
+	"github.com/stretchr/testify/require"
+)
+
+// Records, with CRLF line endings and a byte order mark, needing each repair,
+// followed by a line that is not JSON.
+const testFixData = "\ufeff" + `{"data_source": "TEST", "RECORD_ID": 12, "NAME_FULL": " Bob Smith "}` + "\r\n" +
+	`{"RECORD_ID": "2", "NAMES": [{"name_last": "Jones\t"}]}` + "\r\n" +
+	`{"DATA_SOURCE": "TEST", "RECORD_ID": "3", "NAME_FULL": "Mary Jones"}` + "\r\n" +
+	"not a record\r\n"
+
+// ----------------------------------------------------------------------------
+// test fix
+// ----------------------------------------------------------------------------
+
+func TestBasicValidate_Fix(test *testing.T) {
+	outputFile := filepath.Join(test.TempDir(), "fixed.jsonl")
+	actual, result := fixRecords(test, &validate.BasicValidate{
+		DefaultDataSource: "CUSTOMERS",
+		FixOutputFile:     outputFile,
+	})
+
+	require.Contains(test, actual, "Writing fixed records to "+outputFile)
+	require.Contains(test, actual, "Removed the UTF-8 byte order mark")
+	require.Contains(test, actual, "Line 1: Replaced the CRLF line ending with LF")
+	require.Contains(test, actual, "Line 1: Renamed data_source to DATA_SOURCE")
+	require.Contains(test, actual, "Line 1: Converted RECORD_ID 12 from a number to a string")
+	require.Contains(test, actual, "Line 1: Trimmed white space from NAME_FULL")
+	require.Contains(test, actual, "Line 2: Renamed NAMES[0].name_last to NAME_LAST")
+	require.Contains(test, actual, "Line 2: Trimmed white space from NAMES[0].NAME_LAST")
+	require.Contains(test, actual, `Line 2: Set DATA_SOURCE to "CUSTOMERS"`)
+	require.Contains(test, actual, "Validated 4 lines, 1 were bad")
+	require.True(test, result)
+
+	fixed, err := os.ReadFile(outputFile)
+	require.NoError(test, err)
+
+	expected := `{"DATA_SOURCE":"TEST","RECORD_ID":"12","NAME_FULL":"Bob Smith"}
+{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"2","NAMES":[{"NAME_LAST":"Jones"}]}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "3", "NAME_FULL": "Mary Jones"}
+not a record
+`
+	require.Equal(test, expected, string(fixed))
+}
+
+// ----------------------------------------------------------------------------
+
+// Only the selected Repairs are applied.
+func TestBasicValidate_Fix_repairs(test *testing.T) {
+	outputFile := filepath.Join(test.TempDir(), "fixed.jsonl")
+	actual, result := fixRecords(test, &validate.BasicValidate{
+		FixOutputFile: outputFile,
+		Repairs:       []string{"whitespace"},
+	})
+
+	require.NotContains(test, actual, "Renamed")
+	require.NotContains(test, actual, "Converted")
+	require.NotContains(test, actual, "Removed the UTF-8 byte order mark")
+	require.NotContains(test, actual, "Replaced the CRLF line ending")
+	require.Contains(test, actual, "Line 1: Trimmed white space from NAME_FULL")
+	require.True(test, result)
+
+	fixed, err := os.ReadFile(outputFile)
+	require.NoError(test, err)
+	require.Contains(test, string(fixed), "\ufeff"+`{"data_source":"TEST","RECORD_ID":12,"NAME_FULL":"Bob Smith"}`+"\r\n")
+	require.Contains(test, string(fixed), "not a record\r\n")
+}
+
+// ----------------------------------------------------------------------------
+
+func TestBasicValidate_Fix_unsupported_repair(test *testing.T) {
+	actual, result := fixRecords(test, &validate.BasicValidate{
+		FixOutputFile: filepath.Join(test.TempDir(), "fixed.jsonl"),
+		Repairs:       []string{"whitespace", "spelling"},
+	})
+
+	require.Contains(test, actual, "Fatal error unsupported repair: spelling")
+	require.NotContains(test, actual, "Validated")
+	require.False(test, result)
+}
+
+// ----------------------------------------------------------------------------
+
+func TestBasicValidate_Fix_no_output_file(test *testing.T) {
+	actual, result := fixRecords(test, &validate.BasicValidate{})
+
+	require.Contains(test, actual, "Fatal error fix requires an output-file")
+	require.False(test, result)
+}
+
+// ----------------------------------------------------------------------------
+
+// Fix writes every record, so it does not read only some of them.
+func TestBasicValidate_Fix_head(test *testing.T) {
+	outputFile := filepath.Join(test.TempDir(), "fixed.jsonl")
+	actual, result := fixRecords(test, &validate.BasicValidate{
+		FixOutputFile: outputFile,
+		Head:          3,
+	})
+
+	require.Contains(test, actual, "Fatal error fix writes every record, so head, skip and sample-rate cannot be used")
+	require.NotContains(test, actual, "Validated")
+	require.NoFileExists(test, outputFile)
+	require.False(test, result)
+}
+
+// ----------------------------------------------------------------------------
+// Helper functions
+// ----------------------------------------------------------------------------
+
+// fix testFixData with the validator and return the output and result.
+func fixRecords(t *testing.T, validator *validate.BasicValidate) (string, bool) {
+	t.Helper()
+
+	reader, writer, cleanUp := mockStdout(t)
+	defer cleanUp()
+
+	filename, moreCleanUp := createTempDataFile(t, testFixData, "jsonl")
+	defer moreCleanUp()
+
+	validator.InputURL = "file://" + filename
+	result := validator.Fix(t.Context())
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+
+	return string(out), result
+}
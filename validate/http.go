@@ -0,0 +1,601 @@
+package validate
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+)
+
+// Delay before the first retry of an HTTP request when HTTPRetryBackoff is not set.
+// The delay doubles with each subsequent retry.
+const defaultHTTPRetryBackoff = time.Second
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// idleTimeoutBody is the body of an HTTP response that fails a read, by
+// canceling its request, if no data arrives within timeout.  There is no
+// timer if timeout is 0.
+type idleTimeoutBody struct {
+	body    io.ReadCloser
+	cancel  context.CancelFunc
+	timeout time.Duration
+	timer   *time.Timer
+}
+
+// rangeReader reads an HTTP resource at arbitrary byte offsets using Range
+// requests, for formats such as Parquet that cannot be read as a stream.
+type rangeReader struct {
+	client      *http.Client
+	ctx         context.Context //nolint:containedctx
+	headers     http.Header
+	resourceURL string
+	size        int64
+	validate    *BasicValidate
+	version     string
+}
+
+// resumableBody reads the body of an HTTP response.  If the connection drops
+// before the whole resource is read, the remainder is requested with a Range
+// request starting at the byte offset already consumed, so readers downstream
+// see one uninterrupted stream.
+type resumableBody struct {
+	body        io.ReadCloser
+	client      *http.Client
+	ctx         context.Context //nolint:containedctx
+	headers     http.Header
+	interrupted error
+	offset      int64
+	resourceURL string
+	size        int64
+	stalls      int
+	validate    *BasicValidate
+	version     string
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Create an HTTP client honoring HTTPCABundle and HTTPTimeout.  HTTPTimeout
+// limits the wait for the response headers, not the time spent reading a
+// (possibly very large) response body; getHTTPResource limits the wait for each
+// read of the body instead.
+func (validate *BasicValidate) getHTTPClient() (*http.Client, error) {
+	transport, isOK := http.DefaultTransport.(*http.Transport)
+	if !isOK {
+		return nil, wraperror.Errorf(errForPackage, "http.DefaultTransport is not an *http.Transport")
+	}
+
+	transport = transport.Clone()
+	transport.ResponseHeaderTimeout = validate.HTTPTimeout
+
+	// Byte offsets used to resume downloads must refer to the bytes on the wire.
+	transport.DisableCompression = true
+
+	if validate.HTTPCABundle != "" {
+		pemCerts, err := os.ReadFile(filepath.Clean(validate.HTTPCABundle))
+		if err != nil {
+			return nil, wraperror.Errorf(err, "os.ReadFile(%s)", validate.HTTPCABundle)
+		}
+
+		certPool, err := x509.SystemCertPool()
+		if err != nil {
+			certPool = x509.NewCertPool()
+		}
+
+		if !certPool.AppendCertsFromPEM(pemCerts) {
+			return nil, wraperror.Errorf(errForPackage, "no certificates found in %s", validate.HTTPCABundle)
+		}
+
+		transport.TLSClientConfig = &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			RootCAs:    certPool,
+		}
+	}
+
+	return &http.Client{Transport: transport}, nil //nolint:exhaustruct
+}
+
+// ----------------------------------------------------------------------------
+
+// Build the request headers from HTTPHeaders ("Name: value") and HTTPBearerToken.
+func (validate *BasicValidate) getHTTPHeaders() (http.Header, error) {
+	headers := http.Header{}
+
+	for _, header := range validate.HTTPHeaders {
+		name, value, found := strings.Cut(header, ":")
+		if !found || strings.TrimSpace(name) == "" {
+			return nil, wraperror.Errorf(errForPackage, "HTTP header not in 'Name: value' form: %s", header)
+		}
+
+		headers.Add(strings.TrimSpace(name), strings.TrimSpace(value))
+	}
+
+	if validate.HTTPBearerToken != "" {
+		headers.Set("Authorization", "Bearer "+validate.HTTPBearerToken)
+	}
+
+	return headers, nil
+}
+
+// ----------------------------------------------------------------------------
+
+// Open the body of an HTTP(S) resource.  Failed requests are retried up to
+// HTTPRetries times with exponential backoff.  Errors are logged: fetchError is
+// the message number used when the resource cannot be retrieved.
+func (validate *BasicValidate) openHTTPResource(
+	ctx context.Context,
+	resourceURL string,
+	fetchError int,
+) (io.ReadCloser, bool) {
+	client, err := validate.getHTTPClient()
+	if err != nil {
+		validate.log(5020, err)
+
+		return nil, false
+	}
+
+	headers, err := validate.getHTTPHeaders()
+	if err != nil {
+		validate.log(5020, err)
+
+		return nil, false
+	}
+
+	response, err := validate.getHTTPResource(ctx, client, resourceURL, headers)
+	if err != nil {
+		validate.log(fetchError, resourceURL, err)
+
+		return nil, false
+	}
+
+	if !isSuccessfulStatus(response.StatusCode) {
+		validate.log(5019, resourceURL, response.Status)
+
+		return nil, false
+	}
+
+	var body io.ReadCloser = response.Body
+	if validate.HTTPRetries > 0 && response.Header.Get("Accept-Ranges") == "bytes" {
+		body = newResumableBody(ctx, validate, client, resourceURL, headers, response)
+	}
+
+	return struct {
+		io.Reader
+		io.Closer
+	}{validate.countBytes(body, response.ContentLength), body}, true
+}
+
+// ----------------------------------------------------------------------------
+
+// Open an HTTP(S) resource for reading at arbitrary byte offsets.  The size of
+// the resource, and support for Range requests, is learned by requesting its
+// first byte.  Errors are logged: fetchError is the message number used when
+// the resource cannot be retrieved.
+func (validate *BasicValidate) openHTTPRangeReader(
+	ctx context.Context,
+	resourceURL string,
+	fetchError int,
+) (*rangeReader, bool) {
+	client, err := validate.getHTTPClient()
+	if err != nil {
+		validate.log(5020, err)
+
+		return nil, false
+	}
+
+	headers, err := validate.getHTTPHeaders()
+	if err != nil {
+		validate.log(5020, err)
+
+		return nil, false
+	}
+
+	rangeHeaders := headers.Clone()
+	rangeHeaders.Set("Range", "bytes=0-0")
+
+	response, err := validate.getHTTPResource(ctx, client, resourceURL, rangeHeaders)
+	if err != nil {
+		validate.log(fetchError, resourceURL, err)
+
+		return nil, false
+	}
+
+	response.Body.Close()
+
+	if !isSuccessfulStatus(response.StatusCode) {
+		validate.log(5019, resourceURL, response.Status)
+
+		return nil, false
+	}
+
+	size, err := strconv.ParseInt(contentRangeSize(response), 10, 64)
+	if !isPartialContentAt(response, 0) || err != nil {
+		validate.log(5028, resourceURL)
+
+		return nil, false
+	}
+
+	return &rangeReader{
+		client:      client,
+		ctx:         ctx,
+		headers:     headers,
+		resourceURL: resourceURL,
+		size:        size,
+		validate:    validate,
+		version:     resourceVersion(response),
+	}, true
+}
+
+// ----------------------------------------------------------------------------
+
+// Issue a GET request for the resource, retrying failed requests up to
+// HTTPRetries times with exponential backoff.  Once retries are exhausted, or
+// the failure is not worth retrying, a response with an unsuccessful status is
+// returned with its body closed; the caller must check the status.  Reading the
+// body of a successful response fails if no data arrives within HTTPTimeout.
+func (validate *BasicValidate) getHTTPResource(
+	ctx context.Context,
+	client *http.Client,
+	resourceURL string,
+	headers http.Header,
+) (*http.Response, error) {
+	backoff := validate.HTTPRetryBackoff
+	if backoff <= 0 {
+		backoff = defaultHTTPRetryBackoff
+	}
+
+	for attempt := 1; ; attempt++ {
+		requestCtx, cancel := context.WithCancel(ctx)
+
+		response, err := requestHTTPResource(requestCtx, client, resourceURL, headers)
+		if err == nil && isSuccessfulStatus(response.StatusCode) {
+			response.Body = newIdleTimeoutBody(response.Body, cancel, validate.HTTPTimeout)
+
+			return response, nil
+		}
+
+		if err == nil {
+			response.Body.Close()
+		}
+
+		cancel()
+
+		if attempt > validate.HTTPRetries || !isRetriable(ctx, response, err) {
+			return response, err
+		}
+
+		if err == nil {
+			err = wraperror.Errorf(errForPackage, "HTTP status %s", response.Status)
+		}
+
+		validate.log(2220, resourceURL, backoff, attempt, validate.HTTPRetries, err)
+
+		select {
+		case <-ctx.Done():
+			return nil, wraperror.Errorf(ctx.Err(), "retrying %s", resourceURL)
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Methods for idleTimeoutBody
+// ----------------------------------------------------------------------------
+
+// Close the response body and release its request.
+func (body *idleTimeoutBody) Close() error {
+	if body.timer != nil {
+		body.timer.Stop()
+	}
+
+	err := body.body.Close()
+	body.cancel()
+
+	return wraperror.Errorf(err, wraperror.NoMessage)
+}
+
+// ----------------------------------------------------------------------------
+
+// Read from the response body, canceling the request if no data arrives
+// within the timeout.
+func (body *idleTimeoutBody) Read(buffer []byte) (int, error) {
+	if body.timer == nil {
+		return body.body.Read(buffer) //nolint:wrapcheck
+	}
+
+	body.timer.Reset(body.timeout)
+
+	count, err := body.body.Read(buffer)
+	if !body.timer.Stop() && err != nil {
+		return count, wraperror.Errorf(err, "no data received for %s", body.timeout)
+	}
+
+	return count, err //nolint:wrapcheck
+}
+
+// ----------------------------------------------------------------------------
+// Methods for rangeReader
+// ----------------------------------------------------------------------------
+
+// Read len(buffer) bytes starting at offset with a single Range request.
+func (reader *rangeReader) ReadAt(buffer []byte, offset int64) (int, error) {
+	if offset >= reader.size {
+		return 0, io.EOF
+	}
+
+	end := min(offset+int64(len(buffer)), reader.size)
+
+	headers := reader.headers.Clone()
+	headers.Set("Range", fmt.Sprintf("bytes=%d-%d", offset, end-1))
+
+	if reader.version != "" {
+		headers.Set("If-Range", reader.version)
+	}
+
+	response, err := reader.validate.getHTTPResource(reader.ctx, reader.client, reader.resourceURL, headers)
+	if err != nil {
+		return 0, wraperror.Errorf(err, "reading %s at byte offset %d", reader.resourceURL, offset)
+	}
+
+	defer response.Body.Close()
+
+	if !isPartialContentAt(response, offset) {
+		if response.StatusCode == http.StatusOK {
+			return 0, wraperror.Errorf(errForPackage, "%s changed while being read", reader.resourceURL)
+		}
+
+		return 0, wraperror.Errorf(
+			errForPackage,
+			"reading %s at byte offset %d: HTTP status %s",
+			reader.resourceURL,
+			offset,
+			response.Status,
+		)
+	}
+
+	count, err := io.ReadFull(response.Body, buffer[:end-offset])
+	if err != nil {
+		return count, wraperror.Errorf(err, "reading %s at byte offset %d", reader.resourceURL, offset)
+	}
+
+	if count < len(buffer) {
+		return count, io.EOF
+	}
+
+	return count, nil
+}
+
+// ----------------------------------------------------------------------------
+// Methods for resumableBody
+// ----------------------------------------------------------------------------
+
+// Close the current response body.
+func (body *resumableBody) Close() error {
+	return wraperror.Errorf(body.body.Close(), wraperror.NoMessage)
+}
+
+// ----------------------------------------------------------------------------
+
+// Read from the current response body, resuming with a Range request after
+// an interrupted connection.
+func (body *resumableBody) Read(buffer []byte) (int, error) {
+	if body.interrupted != nil {
+		err := body.resume()
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	count, err := body.body.Read(buffer)
+	body.offset += int64(count)
+
+	if count > 0 {
+		body.stalls = 0
+	}
+
+	switch {
+	case err == nil:
+		return count, nil
+	case errors.Is(err, io.EOF) && (body.size < 0 || body.offset >= body.size):
+		return count, io.EOF
+	case body.ctx.Err() != nil:
+		return count, err //nolint:wrapcheck
+	case errors.Is(err, io.EOF):
+		err = io.ErrUnexpectedEOF
+	}
+
+	body.interrupted = err
+	if count > 0 {
+		return count, nil
+	}
+
+	// Give up if resumed connections keep failing without delivering data.
+	body.stalls++
+	if body.stalls > body.validate.HTTPRetries {
+		return 0, wraperror.Errorf(err, "no progress reading %s at byte offset %d", body.resourceURL, body.offset)
+	}
+
+	return body.Read(buffer)
+}
+
+// ----------------------------------------------------------------------------
+
+// Request the remainder of the resource, retrying up to HTTPRetries times.
+func (body *resumableBody) resume() error {
+	body.body.Close()
+	body.validate.log(2221, body.resourceURL, body.offset, body.interrupted)
+
+	headers := body.headers.Clone()
+	headers.Set("Range", fmt.Sprintf("bytes=%d-", body.offset))
+
+	if body.version != "" {
+		headers.Set("If-Range", body.version)
+	}
+
+	response, err := body.validate.getHTTPResource(body.ctx, body.client, body.resourceURL, headers)
+	if err != nil {
+		return wraperror.Errorf(err, "unable to resume %s at byte offset %d", body.resourceURL, body.offset)
+	}
+
+	if isPartialContentAt(response, body.offset) {
+		body.body = response.Body
+		body.interrupted = nil
+
+		return nil
+	}
+
+	response.Body.Close()
+
+	if response.StatusCode == http.StatusOK {
+		return wraperror.Errorf(errForPackage, "%s changed while being read", body.resourceURL)
+	}
+
+	return wraperror.Errorf(
+		errForPackage,
+		"unable to resume %s at byte offset %d: HTTP status %s",
+		body.resourceURL,
+		body.offset,
+		response.Status,
+	)
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Partial content is only usable if it starts exactly at the requested offset.
+func isPartialContentAt(response *http.Response, offset int64) bool {
+	return response.StatusCode == http.StatusPartialContent &&
+		strings.HasPrefix(response.Header.Get("Content-Range"), fmt.Sprintf("bytes %d-", offset))
+}
+
+// ----------------------------------------------------------------------------
+
+// Return the complete length from a "bytes first-last/complete" Content-Range.
+func contentRangeSize(response *http.Response) string {
+	_, size, _ := strings.Cut(response.Header.Get("Content-Range"), "/")
+
+	return size
+}
+
+// ----------------------------------------------------------------------------
+
+// Connection errors, throttling and server-side failures are worth retrying.
+// Cancellation and client errors are not.
+func isRetriable(ctx context.Context, response *http.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	if err != nil {
+		return true
+	}
+
+	return response.StatusCode == http.StatusTooManyRequests || response.StatusCode >= http.StatusInternalServerError
+}
+
+// ----------------------------------------------------------------------------
+
+func isSuccessfulStatus(statusCode int) bool {
+	return statusCode >= http.StatusOK && statusCode < http.StatusMultipleChoices
+}
+
+// ----------------------------------------------------------------------------
+
+// Wrap a response body in an idleTimeoutBody that calls cancel, canceling its
+// request, if a read waits longer than timeout, or never if timeout is 0.
+// Closing the body calls cancel too.
+func newIdleTimeoutBody(body io.ReadCloser, cancel context.CancelFunc, timeout time.Duration) *idleTimeoutBody {
+	var timer *time.Timer
+
+	if timeout > 0 {
+		timer = time.AfterFunc(timeout, cancel)
+		timer.Stop()
+	}
+
+	return &idleTimeoutBody{
+		body:    body,
+		cancel:  cancel,
+		timeout: timeout,
+		timer:   timer,
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+// Wrap the response in a resumableBody.
+func newResumableBody(
+	ctx context.Context,
+	validate *BasicValidate,
+	client *http.Client,
+	resourceURL string,
+	headers http.Header,
+	response *http.Response,
+) *resumableBody {
+	return &resumableBody{
+		body:        response.Body,
+		client:      client,
+		ctx:         ctx,
+		headers:     headers,
+		interrupted: nil,
+		offset:      0,
+		resourceURL: resourceURL,
+		size:        response.ContentLength,
+		stalls:      0,
+		validate:    validate,
+		version:     resourceVersion(response),
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+// Identify the version of a resource for use in If-Range headers, so a changed
+// resource is not spliced onto the old one: a strong ETag, or else Last-Modified.
+func resourceVersion(response *http.Response) string {
+	version := response.Header.Get("ETag")
+	if version == "" || strings.HasPrefix(version, "W/") {
+		version = response.Header.Get("Last-Modified")
+	}
+
+	return version
+}
+
+// ----------------------------------------------------------------------------
+
+// Issue a single GET request for the resource.
+func requestHTTPResource(
+	ctx context.Context,
+	client *http.Client,
+	resourceURL string,
+	headers http.Header,
+) (*http.Response, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, resourceURL, nil)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "http.NewRequestWithContext")
+	}
+
+	request.Header = headers.Clone()
+
+	response, err := client.Do(request) //nolint:gosec
+	if err != nil {
+		return nil, wraperror.Errorf(err, "client.Do")
+	}
+
+	return response, nil
+}
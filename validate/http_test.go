@@ -0,0 +1,594 @@
+//go:build !windows
+
+package validate_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/senzing-garage/validate/validate"
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------------------------------------------------------------
+// test Read resources with HTTP status, retries, headers and TLS
+// ----------------------------------------------------------------------------
+
+func TestBasicValidate_Read_resource_jsonl_not_found(test *testing.T) {
+	ctx := test.Context()
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	validator := &validate.BasicValidate{
+		InputURL: server.URL + "/missing.jsonl",
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "returned HTTP status 404 Not Found")
+	require.NotContains(test, actual, "Validated")
+	require.False(test, result)
+}
+
+func TestBasicValidate_Read_resource_gzip_not_found(test *testing.T) {
+	ctx := test.Context()
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	validator := &validate.BasicValidate{
+		InputURL: server.URL + "/missing.jsonl.gz",
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "returned HTTP status 404 Not Found")
+	require.False(test, result)
+}
+
+func TestBasicValidate_Read_resource_retry(test *testing.T) {
+	ctx := test.Context()
+
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		if requests.Add(1) <= 2 {
+			http.Error(writer, "try again", http.StatusServiceUnavailable)
+
+			return
+		}
+
+		_, _ = io.WriteString(writer, testGoodData)
+	}))
+	defer server.Close()
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	validator := &validate.BasicValidate{
+		HTTPRetries:      2,
+		HTTPRetryBackoff: time.Millisecond,
+		InputURL:         server.URL + "/good.jsonl",
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "Retrying "+server.URL+"/good.jsonl")
+	require.Contains(test, actual, expected12good)
+	require.Equal(test, int32(3), requests.Load())
+	require.True(test, result)
+}
+
+func TestBasicValidate_Read_resource_retries_exhausted(test *testing.T) {
+	ctx := test.Context()
+
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		http.Error(writer, "try again", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	validator := &validate.BasicValidate{
+		HTTPRetries:      2,
+		HTTPRetryBackoff: time.Millisecond,
+		InputURL:         server.URL + "/good.jsonl",
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "returned HTTP status 503 Service Unavailable")
+	require.Equal(test, int32(3), requests.Load())
+	require.False(test, result)
+}
+
+func TestBasicValidate_Read_resource_client_error_not_retried(test *testing.T) {
+	ctx := test.Context()
+
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		http.Error(writer, "forbidden", http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	validator := &validate.BasicValidate{
+		HTTPRetries:      2,
+		HTTPRetryBackoff: time.Millisecond,
+		InputURL:         server.URL + "/good.jsonl",
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "returned HTTP status 403 Forbidden")
+	require.Equal(test, int32(1), requests.Load())
+	require.False(test, result)
+}
+
+func TestBasicValidate_Read_resource_headers(test *testing.T) {
+	ctx := test.Context()
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.Header.Get("Authorization") != "Bearer secret-token" || request.Header.Get("X-Tenant") != "acme" {
+			http.Error(writer, "unauthorized", http.StatusUnauthorized)
+
+			return
+		}
+
+		_, _ = io.WriteString(writer, testGoodData)
+	}))
+	defer server.Close()
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	validator := &validate.BasicValidate{
+		HTTPBearerToken: "secret-token",
+		HTTPHeaders:     []string{"X-Tenant: acme"},
+		InputURL:        server.URL + "/good.jsonl",
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, expected12good)
+	require.True(test, result)
+}
+
+func TestBasicValidate_Read_resource_bad_header(test *testing.T) {
+	ctx := test.Context()
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	validator := &validate.BasicValidate{
+		HTTPHeaders: []string{"no-colon-here"},
+		InputURL:    "http://localhost/good.jsonl",
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "Fatal error configuring HTTP client")
+	require.False(test, result)
+}
+
+func TestBasicValidate_Read_resource_timeout(test *testing.T) {
+	ctx := test.Context()
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+
+		_, _ = io.WriteString(writer, testGoodData)
+	}))
+	defer server.Close()
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	validator := &validate.BasicValidate{
+		HTTPTimeout: 20 * time.Millisecond,
+		InputURL:    server.URL + "/good.jsonl",
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "Fatal error retrieving input-url")
+	require.Contains(test, actual, "timeout")
+	require.False(test, result)
+}
+
+// A body that stops arriving fails the read after HTTPTimeout, rather than
+// waiting forever.
+func TestBasicValidate_Read_resource_body_timeout(test *testing.T) {
+	ctx := test.Context()
+	server, _ := serveStallingConnection(test, []byte(testGoodData))
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	validator := &validate.BasicValidate{
+		HTTPTimeout: 50 * time.Millisecond,
+		InputURL:    server.URL + "/good.jsonl",
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "Fatal error reading input after line")
+	require.Contains(test, actual, "no data received for 50ms")
+	require.False(test, result)
+}
+
+func TestBasicValidate_Read_resource_canceled(test *testing.T) {
+	ctx, cancel := context.WithCancel(test.Context())
+	cancel()
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	validator := &validate.BasicValidate{
+		HTTPRetries: 3,
+		InputURL:    "http://localhost/good.jsonl",
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "context canceled")
+	require.NotContains(test, actual, "Retrying")
+	require.False(test, result)
+}
+
+func TestBasicValidate_Read_resource_ca_bundle(test *testing.T) {
+	ctx := test.Context()
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		_, _ = io.WriteString(writer, testGoodData)
+	}))
+	defer server.Close()
+
+	caBundle := filepath.Join(test.TempDir(), "ca.pem")
+	pemBytes := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	require.NoError(test, os.WriteFile(caBundle, pemBytes, 0o600))
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	validator := &validate.BasicValidate{
+		HTTPCABundle: caBundle,
+		InputURL:     server.URL + "/good.jsonl",
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, expected12good)
+	require.True(test, result)
+}
+
+func TestBasicValidate_Read_resource_untrusted_certificate(test *testing.T) {
+	ctx := test.Context()
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		_, _ = io.WriteString(writer, testGoodData)
+	}))
+	defer server.Close()
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	validator := &validate.BasicValidate{
+		InputURL: server.URL + "/good.jsonl",
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "certificate")
+	require.False(test, result)
+}
+
+func TestBasicValidate_Read_resource_bad_ca_bundle(test *testing.T) {
+	ctx := test.Context()
+
+	caBundle := filepath.Join(test.TempDir(), "ca.pem")
+	require.NoError(test, os.WriteFile(caBundle, []byte("not a certificate"), 0o600))
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	validator := &validate.BasicValidate{
+		HTTPCABundle: caBundle,
+		InputURL:     "https://localhost/good.jsonl",
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "no certificates found in "+caBundle)
+	require.False(test, result)
+}
+
+// ----------------------------------------------------------------------------
+// test Read resources resuming after dropped connections
+// ----------------------------------------------------------------------------
+
+func TestBasicValidate_Read_resource_resume_jsonl(test *testing.T) {
+	ctx := test.Context()
+	server, requests := serveDroppingConnection(test, []byte(testGoodData), true, false)
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	validator := &validate.BasicValidate{
+		HTTPRetries:      1,
+		HTTPRetryBackoff: time.Millisecond,
+		InputURL:         server.URL + "/good.jsonl",
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, fmt.Sprintf("Resuming %s/good.jsonl at byte offset %d", server.URL, len(testGoodData)/2))
+	require.Contains(test, actual, expected12good)
+	require.Equal(test, int32(2), requests.Load())
+	require.True(test, result)
+}
+
+func TestBasicValidate_Read_resource_resume_gzip(test *testing.T) {
+	ctx := test.Context()
+	server, requests := serveDroppingConnection(test, gzipBytes(test, testBadData), true, false)
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	validator := &validate.BasicValidate{
+		HTTPRetries:      1,
+		HTTPRetryBackoff: time.Millisecond,
+		InputURL:         server.URL + "/bad.jsonl.gz",
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "Resuming "+server.URL+"/bad.jsonl.gz")
+	require.Contains(test, actual, expected16good4bad)
+	require.Equal(test, int32(2), requests.Load())
+	require.True(test, result)
+}
+
+func TestBasicValidate_Read_resource_resume_not_supported(test *testing.T) {
+	ctx := test.Context()
+	server, requests := serveDroppingConnection(test, []byte(testGoodData), false, false)
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	validator := &validate.BasicValidate{
+		HTTPRetries:      1,
+		HTTPRetryBackoff: time.Millisecond,
+		InputURL:         server.URL + "/good.jsonl",
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.NotContains(test, actual, "Resuming")
+	require.Contains(test, actual, "Fatal error reading input after line")
+	require.Equal(test, int32(1), requests.Load())
+	require.False(test, result)
+}
+
+func TestBasicValidate_Read_resource_resume_changed(test *testing.T) {
+	ctx := test.Context()
+	server, _ := serveDroppingConnection(test, []byte(testGoodData), true, true)
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	validator := &validate.BasicValidate{
+		HTTPRetries:      1,
+		HTTPRetryBackoff: time.Millisecond,
+		InputURL:         server.URL + "/good.jsonl",
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "changed while being read")
+	require.False(test, result)
+}
+
+func TestBasicValidate_Read_resource_resume_body_timeout(test *testing.T) {
+	ctx := test.Context()
+	server, requests := serveStallingConnection(test, []byte(testGoodData))
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	validator := &validate.BasicValidate{
+		HTTPRetries:      1,
+		HTTPRetryBackoff: time.Millisecond,
+		HTTPTimeout:      50 * time.Millisecond,
+		InputURL:         server.URL + "/good.jsonl",
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, fmt.Sprintf("Resuming %s/good.jsonl at byte offset %d", server.URL, len(testGoodData)/2))
+	require.Contains(test, actual, expected12good)
+	require.Equal(test, int32(2), requests.Load())
+	require.True(test, result)
+}
+
+// ----------------------------------------------------------------------------
+// Helper functions
+// ----------------------------------------------------------------------------
+
+// serve content, dropping the connection half way through the first response.
+// If acceptRanges, later requests may ask for a byte range.  If changeETag, the
+// resource appears to change after the first response.
+func serveDroppingConnection(
+	t *testing.T,
+	content []byte,
+	acceptRanges bool,
+	changeETag bool,
+) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	requests := &atomic.Int32{}
+
+	handler := http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		attempt := requests.Add(1)
+
+		etag := `"v1"`
+		if changeETag && attempt > 1 {
+			etag = `"v2"`
+		}
+
+		writer.Header().Set("ETag", etag)
+
+		if attempt == 1 {
+			if acceptRanges {
+				writer.Header().Set("Accept-Ranges", "bytes")
+			}
+
+			writer.Header().Set("Content-Length", strconv.Itoa(len(content)))
+			_, _ = writer.Write(content[:len(content)/2])
+
+			if flusher, isOK := writer.(http.Flusher); isOK {
+				flusher.Flush()
+			}
+
+			panic(http.ErrAbortHandler)
+		}
+
+		http.ServeContent(writer, request, "", time.Time{}, bytes.NewReader(content))
+	})
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return server, requests
+}
+
+// ----------------------------------------------------------------------------
+
+// serve content, accepting byte ranges, but stop sending the first response
+// half way through until the client gives up on it.
+func serveStallingConnection(t *testing.T, content []byte) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	requests := &atomic.Int32{}
+
+	handler := http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.Header().Set("Accept-Ranges", "bytes")
+		writer.Header().Set("ETag", `"v1"`)
+
+		if requests.Add(1) == 1 {
+			writer.Header().Set("Content-Length", strconv.Itoa(len(content)))
+			_, _ = writer.Write(content[:len(content)/2])
+
+			if flusher, isOK := writer.(http.Flusher); isOK {
+				flusher.Flush()
+			}
+
+			<-request.Context().Done()
+
+			return
+		}
+
+		http.ServeContent(writer, request, "", time.Time{}, bytes.NewReader(content))
+	})
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return server, requests
+}
@@ -0,0 +1,223 @@
+package validate
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// Letters and digits, optionally separated by spaces and punctuation.
+var genericIdentifierPattern = regexp.MustCompile(`^[A-Z0-9][A-Z0-9 ./-]{2,38}[A-Z0-9]$`)
+
+// Identifier formats checked unless replaced by BasicValidate.IdentifierFormats.
+var defaultIdentifierFormats = []IdentifierFormat{
+	{
+		Attribute:    "SSN_NUMBER",
+		IsInvalid:    isUnissuedSSN,
+		Pattern:      regexp.MustCompile(`^\d{3}[- ]?\d{2}[- ]?\d{4}$`),
+		Placeholders: []string{"078-05-1120", "219-09-9999", "123-45-6789"},
+	},
+	{
+		Attribute:    "SSN_LAST4",
+		Pattern:      regexp.MustCompile(`^\d{4}$`),
+		Placeholders: []string{"0000"},
+	},
+	{
+		Attribute:        "PASSPORT_NUMBER",
+		CountryAttribute: "PASSPORT_COUNTRY",
+		Pattern:          regexp.MustCompile(`^[A-Z0-9][A-Z0-9 -]{3,18}[A-Z0-9]$`),
+	},
+	{
+		Attribute:        "NATIONAL_ID_NUMBER",
+		CountryAttribute: "NATIONAL_ID_COUNTRY",
+		Pattern:          genericIdentifierPattern,
+	},
+	{
+		Attribute:        "TAX_ID_NUMBER",
+		CountryAttribute: "TAX_ID_COUNTRY",
+		Pattern:          genericIdentifierPattern,
+	},
+}
+
+// Words, without spaces or punctuation, entered in place of any identifier.
+var placeholderIdentifiers = []string{
+	"APPLIEDFOR",
+	"NA",
+	"NONE",
+	"NOTAPPLICABLE",
+	"NOTPROVIDED",
+	"NULL",
+	"PENDING",
+	"TBD",
+	"UNK",
+	"UNKNOWN",
+}
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// IdentifierFormat describes the values accepted for an identifier attribute,
+// such as SSN_NUMBER.  Formats given in BasicValidate.IdentifierFormats replace
+// the built-in format for the same attribute or add checks for a new one.
+type IdentifierFormat struct {
+	// The attribute holding the identifier, e.g. "PASSPORT_NUMBER".
+	Attribute string
+
+	// The attribute giving the issuing country of the identifier, which must
+	// be in the same object, e.g. "PASSPORT_COUNTRY".  Empty if the identifier
+	// is not country scoped.
+	CountryAttribute string
+
+	// Optionally reports values that match Pattern but are still not valid,
+	// e.g. social security numbers that are never issued.
+	IsInvalid func(value string) bool
+
+	// The pattern an identifier, upper cased and trimmed, must match.  Nil
+	// accepts any value.
+	Pattern *regexp.Regexp
+
+	// Values entered when the identifier is not known, compared ignoring case,
+	// spaces and punctuation.  Values of six or more characters that are a
+	// single repeated character or a run of consecutive digits, and common
+	// words such as "UNKNOWN", are always placeholders.
+	Placeholders []string
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Report identifiers that are placeholders, are not in the format of their
+// attribute, or lack the attribute giving their issuing country.
+func (validate *BasicValidate) checkIdentifiers(object jsonObject) []issue {
+	var issues []issue
+
+	formats := validate.identifierFormats()
+
+	walkJSON(object, "", func(path string, value any) {
+		identifierObject, isObject := value.(jsonObject)
+		if !isObject {
+			return
+		}
+
+		for _, member := range identifierObject {
+			format, isFound := formats[member.Key]
+			identifier, isString := member.Value.(string)
+
+			if !isFound || !isString || strings.TrimSpace(identifier) == "" {
+				continue
+			}
+
+			memberPath := joinJSONPath(path, member.Key)
+
+			switch {
+			case format.isPlaceholder(identifier):
+				issues = append(issues, newWarning(3062, 3063, memberPath, identifier))
+			case !format.isValid(identifier):
+				issues = append(issues, newWarning(3060, 3061, memberPath, identifier))
+			}
+
+			if format.CountryAttribute != "" && strings.TrimSpace(identifierObject.getString(format.CountryAttribute)) == "" {
+				issues = append(issues, newWarning(3064, 3065, memberPath, format.CountryAttribute))
+			}
+		}
+	})
+
+	return issues
+}
+
+// ----------------------------------------------------------------------------
+
+// The identifier formats to check, keyed by attribute.
+func (validate *BasicValidate) identifierFormats() map[string]IdentifierFormat {
+	if validate.identifierFormatsByAttribute == nil {
+		validate.identifierFormatsByAttribute = map[string]IdentifierFormat{}
+		for _, format := range slices.Concat(defaultIdentifierFormats, validate.IdentifierFormats) {
+			validate.identifierFormatsByAttribute[format.Attribute] = format
+		}
+	}
+
+	return validate.identifierFormatsByAttribute
+}
+
+// ----------------------------------------------------------------------------
+// Methods for IdentifierFormat
+// ----------------------------------------------------------------------------
+
+func (format IdentifierFormat) isPlaceholder(identifier string) bool {
+	normalized := normalizeIdentifier(identifier)
+
+	if slices.Contains(placeholderIdentifiers, normalized) || isRepeatedOrConsecutive(normalized) {
+		return true
+	}
+
+	return slices.ContainsFunc(format.Placeholders, func(placeholder string) bool {
+		return normalizeIdentifier(placeholder) == normalized
+	})
+}
+
+// ----------------------------------------------------------------------------
+
+func (format IdentifierFormat) isValid(identifier string) bool {
+	identifier = strings.ToUpper(strings.TrimSpace(identifier))
+
+	if format.Pattern != nil && !format.Pattern.MatchString(identifier) {
+		return false
+	}
+
+	return format.IsInvalid == nil || !format.IsInvalid(identifier)
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Social security numbers with an area of 000, 666 or 900-999, a group of 00 or
+// a serial of 0000 are never issued.
+func isUnissuedSSN(ssn string) bool {
+	digits := normalizeIdentifier(ssn)
+	if len(digits) != 9 {
+		return false
+	}
+
+	area, group, serial := digits[:3], digits[3:5], digits[5:]
+
+	return area == "000" || area == "666" || area[0] == '9' || group == "00" || serial == "0000"
+}
+
+// ----------------------------------------------------------------------------
+
+// Values of six or more characters that are one repeated character, e.g.
+// "000000000", or consecutive digits, e.g. "123456789" or "987654321".
+func isRepeatedOrConsecutive(identifier string) bool {
+	if len(identifier) < 6 {
+		return false
+	}
+
+	isRepeated, isAscending, isDescending := true, true, true
+
+	for index := 1; index < len(identifier); index++ {
+		previous, current := identifier[index-1], identifier[index]
+		isDigits := unicode.IsDigit(rune(previous)) && unicode.IsDigit(rune(current))
+		isRepeated = isRepeated && current == previous
+		isAscending = isAscending && isDigits && (current == previous+1 || previous == '9' && current == '0')
+		isDescending = isDescending && isDigits && (current+1 == previous || previous == '0' && current == '9')
+	}
+
+	return isRepeated || isAscending || isDescending
+}
+
+// ----------------------------------------------------------------------------
+
+// Upper case an identifier and remove everything but letters and digits.
+func normalizeIdentifier(identifier string) string {
+	return strings.Map(func(char rune) rune {
+		if unicode.IsLetter(char) || unicode.IsDigit(char) {
+			return unicode.ToUpper(char)
+		}
+
+		return -1
+	}, identifier)
+}
@@ -0,0 +1,85 @@
+//go:build !windows
+
+package validate_test
+
+import (
+	"io"
+	"regexp"
+	"testing"
+
+	"github.com/senzing-garage/validate/validate"
+	"github.com/stretchr/testify/require"
+)
+
+const testIdentifierData = `{"DATA_SOURCE": "TEST", "RECORD_ID": "1", "SSN_NUMBER": "999-99-9999"}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "2", "SSN_NUMBER": "000-12-3456"}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "3", "SSN_NUMBER": "53-622-123"}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "4", "PASSPORT_NUMBER": "X1234567"}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "5", "IDS": [{"NATIONAL_ID_NUMBER": "Unknown", "NATIONAL_ID_COUNTRY": "FR"}, {"TAX_ID_NUMBER": "0000000000", "TAX_ID_COUNTRY": "DE"}]}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "6", "SSN_NUMBER": "536-22-1234", "PASSPORT_NUMBER": "X1234567", "PASSPORT_COUNTRY": "US"}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "7", "EMPLOYEE_ID": "E12345"}
+`
+
+// ----------------------------------------------------------------------------
+// test identifier validation
+// ----------------------------------------------------------------------------
+
+func TestBasicValidate_Read_identifiers(test *testing.T) {
+	actual, result := readIdentifiers(test, nil)
+
+	require.Contains(test, actual, `Line 1: SSN_NUMBER is a placeholder, not an identifier: "999-99-9999"`)
+	require.Contains(test, actual, `Line 2: SSN_NUMBER is not in a valid format: "000-12-3456"`)
+	require.Contains(test, actual, `Line 3: SSN_NUMBER is not in a valid format: "53-622-123"`)
+	require.Contains(test, actual, "Line 4: PASSPORT_NUMBER has no PASSPORT_COUNTRY")
+	require.Contains(test, actual, `Line 5: IDS[0].NATIONAL_ID_NUMBER is a placeholder, not an identifier: "Unknown"`)
+	require.Contains(test, actual, `Line 5: IDS[1].TAX_ID_NUMBER is a placeholder, not an identifier: "0000000000"`)
+	require.NotContains(test, actual, "Line 6:")
+	require.NotContains(test, actual, "Line 7:")
+	require.Contains(test, actual, "2 record(s) had identifiers in an invalid format")
+	require.Contains(test, actual, "2 record(s) had placeholder identifiers")
+	require.Contains(test, actual, "1 record(s) had country scoped identifiers without their country")
+	require.Contains(test, actual, "Validated 7 lines, 0 were bad")
+	require.True(test, result)
+}
+
+// IdentifierFormats adds formats for other attributes and replaces built-in ones.
+func TestBasicValidate_Read_identifiers_formats(test *testing.T) {
+	actual, result := readIdentifiers(test, []validate.IdentifierFormat{
+		{Attribute: "EMPLOYEE_ID", Pattern: regexp.MustCompile(`^E\d{7}$`)},
+		{Attribute: "PASSPORT_NUMBER"},
+	})
+
+	require.Contains(test, actual, `Line 7: EMPLOYEE_ID is not in a valid format: "E12345"`)
+	require.NotContains(test, actual, "PASSPORT_COUNTRY")
+	require.Contains(test, actual, `Line 1: SSN_NUMBER is a placeholder, not an identifier: "999-99-9999"`)
+	require.Contains(test, actual, "3 record(s) had identifiers in an invalid format")
+	require.True(test, result)
+}
+
+// ----------------------------------------------------------------------------
+// Helper functions
+// ----------------------------------------------------------------------------
+
+// read testIdentifierData with the given identifier formats and return the
+// output and result.
+func readIdentifiers(t *testing.T, formats []validate.IdentifierFormat) (string, bool) {
+	t.Helper()
+
+	reader, writer, cleanUp := mockStdout(t)
+	defer cleanUp()
+
+	filename, moreCleanUp := createTempDataFile(t, testIdentifierData, "jsonl")
+	defer moreCleanUp()
+
+	validator := &validate.BasicValidate{
+		IdentifierFormats: formats,
+		InputURL:          "file://" + filename,
+	}
+	result := validator.Read(t.Context())
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+
+	return string(out), result
+}
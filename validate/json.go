@@ -0,0 +1,227 @@
+package validate
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+)
+
+// ----------------------------------------------------------------------------
+// Public methods
+// ----------------------------------------------------------------------------
+
+// validate that each element of a JSON array read from the reader is a valid
+// record, or only the elements selected by Skip, Head and SampleRate.  The
+// array is decoded one element at a time, so it is never held in memory as a
+// whole.  A reader holding a single JSON object is validated as a
+// single record, and one holding several, such as a JSONL file named .json, as
+// JSONL.  Returns false if the input is not a well formed JSON array or object,
+// has data after it, or the context was canceled before the array was read.
+func (validate *BasicValidate) ValidateJSON(ctx context.Context, reader io.Reader) bool {
+	bufferedReader := bufio.NewReader(reader)
+	if peekFirstByte(bufferedReader) == '{' {
+		return validate.validateJSONDocument(ctx, bufferedReader)
+	}
+
+	decoder := json.NewDecoder(bufferedReader)
+	counts := recordCounts{}
+
+	token, err := decoder.Token()
+	if err != nil || token != json.Delim('[') {
+		validate.log(5023, decoder.InputOffset())
+
+		return false
+	}
+
+	sample := validate.newRecordSample("JSON records", 2211)
+	index := 0
+
+	for ; decoder.More(); index++ {
+		if sample.isDone(index + 1) {
+			// The rest of the array is not read.
+			validate.logCounts(counts)
+			validate.logSample(sample, counts.bad())
+
+			return true
+		}
+
+		if validate.logCanceled(ctx, sample.read, "JSON records") {
+			validate.logCounts(counts)
+			validate.logSample(sample, counts.bad())
+
+			return false
+		}
+
+		var element json.RawMessage
+
+		err = decoder.Decode(&element)
+		if err != nil {
+			validate.logCounts(counts)
+			validate.logSample(sample, counts.bad())
+			validate.log(5024, index, decoder.InputOffset(), err)
+
+			return false
+		}
+
+		if !sample.isSelected(index + 1) {
+			continue
+		}
+
+		offset := decoder.InputOffset() - int64(len(element))
+		location := fmt.Sprintf("Array element %d at byte offset %d", index, offset)
+		validate.validateRecord(&counts, location, string(element))
+	}
+
+	validate.logCounts(counts)
+	validate.logSample(sample, counts.bad())
+
+	_, err = decoder.Token()
+	if err != nil {
+		validate.log(5024, index, decoder.InputOffset(), err)
+
+		return false
+	}
+
+	err = checkJSONEnd(decoder)
+	if err != nil {
+		validate.log(5040, "array", decoder.InputOffset(), err)
+
+		return false
+	}
+
+	return true
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// validate a reader holding one JSON object, possibly spread across many lines.
+// If another object follows it, the reader is validated as JSONL from the
+// start, which the bytes decoded so far are kept for.
+func (validate *BasicValidate) validateJSONDocument(ctx context.Context, reader io.Reader) bool {
+	var decoded bytes.Buffer
+
+	decoder := json.NewDecoder(io.TeeReader(reader, &decoded))
+	counts := recordCounts{}
+
+	var document json.RawMessage
+
+	err := decoder.Decode(&document)
+	if err != nil {
+		validate.log(5024, 0, decoder.InputOffset(), err)
+
+		return false
+	}
+
+	token, err := decoder.Token()
+	switch {
+	case errors.Is(err, io.EOF):
+	case token == json.Delim('{'):
+		validate.log(2218)
+
+		return validate.ValidateLines(ctx, io.MultiReader(&decoded, reader))
+	default:
+		validate.log(5040, "object", decoder.InputOffset(), unexpectedJSON(token, err))
+
+		return false
+	}
+
+	sample := validate.newRecordSample("JSON records", 2211)
+	if sample.isSelected(1) {
+		validate.validateRecord(&counts, "JSON document", string(document))
+	}
+
+	validate.logCounts(counts)
+	validate.logSample(sample, counts.bad())
+
+	return true
+}
+
+// ----------------------------------------------------------------------------
+
+// Validate the records in the reader, transcoded to UTF-8, as JSON if the path (less any .gz
+// extension) or the input file type says so, as JSONL if the input file type
+// says so, and otherwise as JSON only if the input starts with a JSON array.
+func (validate *BasicValidate) validateStream(ctx context.Context, reader io.Reader, path string) bool {
+	if !validate.isInputEncodingSupported() {
+		return false
+	}
+
+	decodedReader, err := validate.decodeInput(reader)
+	if err != nil {
+		validate.log(5021, 0, err)
+
+		return false
+	}
+
+	bufferedReader := bufio.NewReader(decodedReader)
+
+	switch {
+	case validate.isJSON(strings.TrimSuffix(path, ".gz")):
+		return validate.ValidateJSON(ctx, bufferedReader)
+	case strings.ToUpper(validate.InputFileType) == "JSONL":
+		return validate.ValidateLines(ctx, bufferedReader)
+	case peekFirstByte(bufferedReader) == '[':
+		validate.log(2212)
+
+		return validate.ValidateJSON(ctx, bufferedReader)
+	default:
+		return validate.ValidateLines(ctx, bufferedReader)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Check that nothing but white space follows the last value decoded.
+func checkJSONEnd(decoder *json.Decoder) error {
+	token, err := decoder.Token()
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+
+	return unexpectedJSON(token, err)
+}
+
+// ----------------------------------------------------------------------------
+
+// Return the first byte that is not JSON whitespace without consuming any
+// input, or 0 if there is none within the reader's buffer.
+func peekFirstByte(reader *bufio.Reader) byte {
+	for size := 1; size <= reader.Size(); size++ {
+		peeked, err := reader.Peek(size)
+		if err != nil {
+			return 0
+		}
+
+		switch peeked[size-1] {
+		case ' ', '\t', '\r', '\n':
+			continue
+		default:
+			return peeked[size-1]
+		}
+	}
+
+	return 0
+}
+
+// ----------------------------------------------------------------------------
+
+// The error for a token, or a failure to read one, found where the input
+// should have ended.
+func unexpectedJSON(token json.Token, err error) error {
+	if err != nil {
+		return wraperror.Errorf(err, wraperror.NoMessage)
+	}
+
+	return wraperror.Errorf(errForPackage, "unexpected %v", token)
+}
@@ -0,0 +1,309 @@
+//go:build !windows
+
+package validate_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/senzing-garage/validate/validate"
+	"github.com/stretchr/testify/require"
+)
+
+const expected12goodJSON = "Validated 12 JSON records, 0 were bad"
+
+// ----------------------------------------------------------------------------
+// test Read JSON
+// ----------------------------------------------------------------------------
+
+// read a .json file holding a JSON array.
+func TestBasicValidate_Read_json_file(test *testing.T) {
+	ctx := test.Context()
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	filename, moreCleanUp := createTempDataFile(test, jsonArrayOf(testGoodData), "json")
+	defer moreCleanUp()
+
+	validator := &validate.BasicValidate{
+		InputURL: "file://" + filename,
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "Validating as a JSON file")
+	require.Contains(test, actual, expected12goodJSON)
+	require.True(test, result)
+}
+
+// read a .jsonl file that actually holds a JSON array.
+func TestBasicValidate_Read_json_sniffed(test *testing.T) {
+	ctx := test.Context()
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	filename, moreCleanUp := createTempDataFile(test, jsonArrayOf(testGoodData), "jsonl")
+	defer moreCleanUp()
+
+	validator := &validate.BasicValidate{
+		InputURL: "file://" + filename,
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "Input starts with a JSON array")
+	require.Contains(test, actual, expected12goodJSON)
+	require.True(test, result)
+}
+
+// read a file with an unknown extension as JSON using the file type override.
+func TestBasicValidate_Read_json_override_file_type(test *testing.T) {
+	ctx := test.Context()
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	document := "{\n  \"DATA_SOURCE\": \"TEST\",\n  \"RECORD_ID\": \"1\"\n}\n"
+
+	filename, moreCleanUp := createTempDataFile(test, document, "txt")
+	defer moreCleanUp()
+
+	validator := &validate.BasicValidate{
+		InputFileType: "JSON",
+		InputURL:      "file://" + filename,
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "Validated 1 JSON records, 0 were bad")
+	require.True(test, result)
+}
+
+// read a .json file that actually holds JSON lines, validating every line.
+func TestBasicValidate_Read_json_file_of_lines(test *testing.T) {
+	ctx := test.Context()
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	filename, moreCleanUp := createTempDataFile(test, testBadData, "json")
+	defer moreCleanUp()
+
+	validator := &validate.BasicValidate{
+		InputURL: "file://" + filename,
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "Input holds more than one JSON object, validating as JSONL")
+	require.Contains(test, actual, expected16good4bad)
+	require.True(test, result)
+}
+
+// read a gzipped JSON array.
+func TestBasicValidate_Read_json_gz(test *testing.T) {
+	ctx := test.Context()
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	filename, moreCleanUp := createTempGZIPDataFile(test, jsonArrayOf(testGoodData))
+	defer moreCleanUp()
+
+	validator := &validate.BasicValidate{
+		InputURL: "file://" + filename,
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, expected12goodJSON)
+	require.True(test, result)
+}
+
+// ----------------------------------------------------------------------------
+// test ValidateJSON
+// ----------------------------------------------------------------------------
+
+// validate array elements, reporting the index and byte offset of bad ones.
+func TestBasicValidate_ValidateJSON_with_validation_errors(test *testing.T) {
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	input := `[
+  {"DATA_SOURCE": "TEST", "RECORD_ID": "1"},
+  {"DATA_SOURCE": "TEST"},
+  {"RECORD_ID": "3"},
+  "not a record"
+]`
+
+	validator := &validate.BasicValidate{}
+	result := validator.ValidateJSON(test.Context(), strings.NewReader(input))
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.NotContains(test, actual, "Array element 0")
+	require.Contains(test, actual, "Array element 1 at byte offset 49: ")
+	require.Contains(test, actual, "Array element 2 at byte offset 76: ")
+	require.Contains(test, actual, "Array element 3 at byte offset 98: ")
+	require.Contains(test, actual, "Validated 4 JSON records, 3 were bad")
+	require.True(test, result)
+}
+
+// validate an empty array.
+func TestBasicValidate_ValidateJSON_empty(test *testing.T) {
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	validator := &validate.BasicValidate{}
+	result := validator.ValidateJSON(test.Context(), strings.NewReader(" [ ] "))
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "Validated 0 JSON records, 0 were bad")
+	require.True(test, result)
+}
+
+// attempt to validate something that is neither an array nor an object.
+func TestBasicValidate_ValidateJSON_not_an_array(test *testing.T) {
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	validator := &validate.BasicValidate{}
+	result := validator.ValidateJSON(test.Context(), strings.NewReader(`"just a string"`))
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "Fatal error input is not a JSON array or object")
+	require.False(test, result)
+}
+
+// attempt to validate an array that is cut off, reporting what was validated.
+func TestBasicValidate_ValidateJSON_truncated(test *testing.T) {
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	input := `[{"DATA_SOURCE": "TEST", "RECORD_ID": "1"}, {"DATA_SOURCE": "TEST", "RECO`
+
+	validator := &validate.BasicValidate{}
+	result := validator.ValidateJSON(test.Context(), strings.NewReader(input))
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "Validated 1 JSON records, 0 were bad")
+	require.Contains(test, actual, "Fatal error parsing JSON array element 1")
+	require.False(test, result)
+}
+
+// validate only the array elements selected by Skip, Head and SampleRate.
+func TestBasicValidate_ValidateJSON_sample(test *testing.T) {
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	input := jsonArrayOf(testGoodData)
+
+	validator := &validate.BasicValidate{Head: 2, Skip: 1}
+	result := validator.ValidateJSON(test.Context(), strings.NewReader(input))
+	require.True(test, result)
+
+	validator = &validate.BasicValidate{SampleRate: 0.5, SampleSeed: 42}
+	result = validator.ValidateJSON(test.Context(), strings.NewReader(input))
+	require.True(test, result)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "Skipping the first 1 JSON records")
+	require.Contains(test, actual, "Reading at most 2 JSON records")
+	require.Contains(test, actual, "Validated 2 JSON records")
+	require.Contains(test, actual, "Sampling a fraction 0.5 of JSON records with seed 42")
+	require.Contains(test, actual, "of 12 JSON records read")
+}
+
+// attempt to validate an array followed by more data.
+func TestBasicValidate_ValidateJSON_trailing_data(test *testing.T) {
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	input := `[{"DATA_SOURCE": "TEST", "RECORD_ID": "1"}] garbage`
+
+	validator := &validate.BasicValidate{}
+	result := validator.ValidateJSON(test.Context(), strings.NewReader(input))
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "Validated 1 JSON records, 0 were bad")
+	require.Contains(test, actual, "Fatal error data after the JSON array near byte offset")
+	require.False(test, result)
+}
+
+// attempt to validate an object followed by something other than an object.
+func TestBasicValidate_ValidateJSON_document_trailing_data(test *testing.T) {
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	input := `{"DATA_SOURCE": "TEST", "RECORD_ID": "1"} ["more"]`
+
+	validator := &validate.BasicValidate{}
+	result := validator.ValidateJSON(test.Context(), strings.NewReader(input))
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "Fatal error data after the JSON object near byte offset 43")
+	require.Contains(test, actual, "unexpected [")
+	require.NotContains(test, actual, "Validated")
+	require.False(test, result)
+}
+
+// ----------------------------------------------------------------------------
+// Helper functions
+// ----------------------------------------------------------------------------
+
+// convert JSON lines into a JSON array, one element per line.
+func jsonArrayOf(jsonLines string) string {
+	lines := strings.Split(strings.TrimSpace(jsonLines), "\n")
+
+	return "[\n" + strings.Join(lines, ",\n") + "\n]\n"
+}
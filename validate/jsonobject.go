@@ -0,0 +1,263 @@
+package validate
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// jsonObject is a parsed JSON object whose members are kept in input order, so
+// that records can be inspected by rules and written back out without their
+// attributes being reordered.  Member values are jsonObject, []any, string,
+// json.Number, bool or nil.
+type jsonObject []jsonMember
+
+type jsonMember struct {
+	Key   string
+	Value any
+}
+
+// ----------------------------------------------------------------------------
+// Methods for jsonObject
+// ----------------------------------------------------------------------------
+
+// Return the value of the first member having the key.
+func (object jsonObject) get(key string) (any, bool) {
+	for _, member := range object {
+		if member.Key == key {
+			return member.Value, true
+		}
+	}
+
+	return nil, false
+}
+
+// ----------------------------------------------------------------------------
+
+// Return the string value of the first member having the key, or "" if there
+// is no such member or its value is not a string.
+func (object jsonObject) getString(key string) string {
+	value, _ := object.get(key)
+	str, _ := value.(string)
+
+	return str
+}
+
+// ----------------------------------------------------------------------------
+
+// MarshalJSON writes the members in order.
+func (object jsonObject) MarshalJSON() ([]byte, error) {
+	var buffer bytes.Buffer
+
+	buffer.WriteByte('{')
+
+	for index, member := range object {
+		if index > 0 {
+			buffer.WriteByte(',')
+		}
+
+		key, err := marshalJSON(member.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := marshalJSON(member.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		buffer.Write(key)
+		buffer.WriteByte(':')
+		buffer.Write(value)
+	}
+
+	buffer.WriteByte('}')
+
+	return buffer.Bytes(), nil
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Decode the members of an object whose opening brace has been read.
+func decodeJSONObject(decoder *json.Decoder) (jsonObject, error) {
+	object := jsonObject{}
+
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, wraperror.Errorf(err, "decoder.Token")
+		}
+
+		key, isString := token.(string)
+		if !isString {
+			return nil, wraperror.Errorf(errForPackage, "object key is not a string: %v", token)
+		}
+
+		value, err := decodeJSONValue(decoder)
+		if err != nil {
+			return nil, err
+		}
+
+		object = append(object, jsonMember{Key: key, Value: value})
+	}
+
+	_, err := decoder.Token()
+	if err != nil {
+		return nil, wraperror.Errorf(err, "decoder.Token")
+	}
+
+	return object, nil
+}
+
+// ----------------------------------------------------------------------------
+
+// Decode the next value, keeping object members in order.
+func decodeJSONValue(decoder *json.Decoder) (any, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, wraperror.Errorf(err, "decoder.Token")
+	}
+
+	switch token {
+	case json.Delim('{'):
+		return decodeJSONObject(decoder)
+	case json.Delim('['):
+		array := []any{}
+
+		for decoder.More() {
+			value, err := decodeJSONValue(decoder)
+			if err != nil {
+				return nil, err
+			}
+
+			array = append(array, value)
+		}
+
+		_, err = decoder.Token()
+		if err != nil {
+			return nil, wraperror.Errorf(err, "decoder.Token")
+		}
+
+		return array, nil
+	default:
+		return token, nil
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+// Marshal a value without escaping HTML characters, so strings are written as
+// they were read.
+func marshalJSON(value any) ([]byte, error) {
+	var buffer bytes.Buffer
+
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+
+	err := encoder.Encode(value)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "encoder.Encode")
+	}
+
+	return bytes.TrimSuffix(buffer.Bytes(), []byte("\n")), nil
+}
+
+// ----------------------------------------------------------------------------
+
+// Parse a record, which must be a single JSON object.  Numbers are kept as
+// json.Number so they are written back out exactly as read.  errRecordNotObject
+// and errRecordTrailingData are returned unwrapped, as wraperror does not keep
+// them for errors.Is.
+func parseJSONObject(str string) (jsonObject, error) {
+	decoder := json.NewDecoder(strings.NewReader(str))
+	decoder.UseNumber()
+
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, wraperror.Errorf(err, "decoder.Token")
+	}
+
+	if token != json.Delim('{') {
+		return nil, errRecordNotObject
+	}
+
+	object, err := decodeJSONObject(decoder)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = decoder.Token()
+	if !errors.Is(err, io.EOF) {
+		return nil, errRecordTrailingData
+	}
+
+	return object, nil
+}
+
+// ----------------------------------------------------------------------------
+
+// Return a copy of the value with transform applied to every string value,
+// but not to object keys.
+func transformStrings(value any, transform func(string) string) any {
+	switch typedValue := value.(type) {
+	case jsonObject:
+		result := make(jsonObject, len(typedValue))
+		for index, member := range typedValue {
+			result[index] = jsonMember{Key: member.Key, Value: transformStrings(member.Value, transform)}
+		}
+
+		return result
+	case []any:
+		result := make([]any, len(typedValue))
+		for index, element := range typedValue {
+			result[index] = transformStrings(element, transform)
+		}
+
+		return result
+	case string:
+		return transform(typedValue)
+	default:
+		return value
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+// Call visit for the value and every value nested within it, parents before
+// children.  Each value is identified by its path from the record, e.g.
+// "NAMES[0].NAME_FULL".
+func walkJSON(value any, path string, visit func(path string, value any)) {
+	visit(path, value)
+
+	switch typedValue := value.(type) {
+	case jsonObject:
+		for _, member := range typedValue {
+			walkJSON(member.Value, joinJSONPath(path, member.Key), visit)
+		}
+	case []any:
+		for index, element := range typedValue {
+			walkJSON(element, fmt.Sprintf("%s[%d]", path, index), visit)
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+func joinJSONPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
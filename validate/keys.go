@@ -0,0 +1,112 @@
+package validate
+
+import (
+	"slices"
+	"strings"
+)
+
+// Attributes known without a pattern, in addition to those of personAttributes.
+var knownAttributes = []string{"DATA_SOURCE", "RECORD_ID", "RECORD_TYPE"}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Report keys, anywhere in the record, that differ only in case or surrounding
+// white space from a known attribute, e.g. "record_id" or "NAME_FULL ".  They
+// are errors unless LenientKeys is set.
+func (validate *BasicValidate) checkKeyCase(object jsonObject) []issue {
+	var issues []issue
+
+	newIssue := newError
+	if validate.LenientKeys {
+		newIssue = newWarning
+	}
+
+	walkJSON(object, "", func(path string, value any) {
+		keyObject, isObject := value.(jsonObject)
+		if !isObject {
+			return
+		}
+
+		for _, member := range keyObject {
+			if key := canonicalKey(member.Key); key != member.Key && validate.isKnownAttribute(key) {
+				issues = append(issues, newIssue(3120, 3121, joinJSONPath(path, member.Key), key))
+			}
+		}
+	})
+
+	return issues
+}
+
+// ----------------------------------------------------------------------------
+
+// Whether an attribute is one of the Generic Entity Specification attributes
+// that the rules check, such as RECORD_ID, NAME_FULL or HOME_ADDR_CITY.
+func (validate *BasicValidate) isKnownAttribute(attribute string) bool {
+	_, isIdentifier := validate.identifierFormats()[attribute]
+
+	return isIdentifier || slices.Contains(knownAttributes, attribute) || slices.Contains(personAttributes, attribute) ||
+		isDateAttribute(attribute) || addressAttribute.MatchString(attribute) || emailAttribute.MatchString(attribute) ||
+		nameAttribute.MatchString(attribute) || phoneAttribute.MatchString(attribute)
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// The attribute a key names, upper cased and without surrounding white space.
+func canonicalKey(key string) string {
+	return strings.ToUpper(strings.TrimSpace(key))
+}
+
+// ----------------------------------------------------------------------------
+
+// The value of a key of the object, or, if it has none, of the first key that
+// differs from it only in case or white space.
+func lookupKey(object jsonObject, key string) (any, bool) {
+	if value, isFound := object.get(key); isFound {
+		return value, true
+	}
+
+	for _, member := range object {
+		if canonicalKey(member.Key) == key {
+			return member.Value, true
+		}
+	}
+
+	return nil, false
+}
+
+// ----------------------------------------------------------------------------
+
+// Replace the keys of the object with their canonical form, unless the object
+// already has it.
+func canonicalizeKeys(object jsonObject) jsonObject {
+	for index, member := range object {
+		if key := canonicalKey(member.Key); key != member.Key {
+			if _, isFound := object.get(key); !isFound {
+				object[index].Key = key
+			}
+		}
+	}
+
+	return object
+}
+
+// ----------------------------------------------------------------------------
+
+// Replace the keys of every object in the value with their canonical form, as
+// canonicalizeKeys does.
+func canonicalizeAllKeys(value any) {
+	switch typedValue := value.(type) {
+	case jsonObject:
+		for _, member := range canonicalizeKeys(typedValue) {
+			canonicalizeAllKeys(member.Value)
+		}
+	case []any:
+		for _, element := range typedValue {
+			canonicalizeAllKeys(element)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+//go:build !windows
+
+package validate_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/senzing-garage/validate/validate"
+	"github.com/stretchr/testify/require"
+)
+
+const testKeyData = `{"DATA_SOURCE": "TEST", "record_id": "1", "NAME_FULL": "Bob Smith"}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "2", "name_full": "Unknown"}
+{" DATA_SOURCE": "TEST", "RECORD_ID": "3", "NAME_FULL": "Mary Jones"}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "4", "NAME_FULL": "Sam Brown", "notes": "not a known attribute"}
+{"DATA_SOURCE": "TEST", "record_id": "5", "RECORD_ID": "5"}
+`
+
+// ----------------------------------------------------------------------------
+// test key case
+// ----------------------------------------------------------------------------
+
+func TestBasicValidate_Read_key_case(test *testing.T) {
+	actual, result := readKeyRecords(test, &validate.BasicValidate{})
+
+	require.Contains(test, actual, `Line 1: "record_id" is RECORD_ID in the wrong case or with white space`)
+	require.Contains(test, actual, `Line 2: "name_full" is NAME_FULL in the wrong case or with white space`)
+	require.Contains(test, actual, `Line 3: " DATA_SOURCE" is DATA_SOURCE in the wrong case or with white space`)
+	require.NotContains(test, actual, "Line 4:")
+	require.Contains(test, actual, `Line 5: "record_id" is RECORD_ID in the wrong case or with white space`)
+	require.NotContains(test, actual, "RECORD_ID field is required")
+	require.Contains(test, actual, "4 record(s) had keys differing in case or white space from known attributes")
+	require.Contains(test, actual, "Validated 5 lines, 4 were bad")
+	require.True(test, result)
+}
+
+// ----------------------------------------------------------------------------
+
+// LenientKeys accepts the keys, warning about them, and checks their values.
+func TestBasicValidate_Read_key_case_lenient(test *testing.T) {
+	actual, result := readKeyRecords(test, &validate.BasicValidate{LenientKeys: true})
+
+	require.Contains(test, actual, `Line 1: "record_id" is RECORD_ID in the wrong case or with white space`)
+	require.Contains(test, actual, `Line 2: "name_full" is NAME_FULL in the wrong case or with white space`)
+	require.Contains(test, actual, `Line 2: NAME_FULL is a placeholder or punctuation, not a name: "Unknown"`)
+	require.Contains(test, actual, `Line 3: " DATA_SOURCE" is DATA_SOURCE in the wrong case or with white space`)
+	require.Contains(test, actual, "Validated 5 lines, 0 were bad")
+	require.True(test, result)
+}
+
+// ----------------------------------------------------------------------------
+// Helper functions
+// ----------------------------------------------------------------------------
+
+// Read testKeyData with the validator and return the output and result.
+func readKeyRecords(t *testing.T, validator *validate.BasicValidate) (string, bool) {
+	t.Helper()
+
+	reader, writer, cleanUp := mockStdout(t)
+	defer cleanUp()
+
+	filename, moreCleanUp := createTempDataFile(t, testKeyData, "jsonl")
+	defer moreCleanUp()
+
+	validator.InputURL = "file://" + filename
+	result := validator.Read(t.Context())
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+
+	return string(out), result
+}
@@ -0,0 +1,54 @@
+package validate
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Report a record larger than MaxRecordBytes.  A limit of 0 is no limit.
+func (validate *BasicValidate) checkRecordSize(str string) []issue {
+	size := len(strings.TrimSpace(str))
+	if validate.MaxRecordBytes > 0 && size > validate.MaxRecordBytes {
+		return []issue{newWarning(3110, 3111, size, validate.MaxRecordBytes)}
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+
+// Report a record with more than MaxAttributes attributes, counting those of
+// the objects in its lists, lists with more than MaxListElements elements and
+// strings longer than MaxStringLength characters.  A limit of 0 is no limit.
+func (validate *BasicValidate) checkSizes(object jsonObject) []issue {
+	var (
+		attributes int
+		issues     []issue
+	)
+
+	walkJSON(object, "", func(path string, value any) {
+		switch typedValue := value.(type) {
+		case jsonObject:
+			attributes += len(typedValue)
+		case []any:
+			if validate.MaxListElements > 0 && len(typedValue) > validate.MaxListElements {
+				issues = append(issues, newWarning(3114, 3115, path, len(typedValue), validate.MaxListElements))
+			}
+		case string:
+			length := utf8.RuneCountInString(typedValue)
+			if validate.MaxStringLength > 0 && length > validate.MaxStringLength {
+				issues = append(issues, newWarning(3116, 3117, path, length, validate.MaxStringLength))
+			}
+		}
+	})
+
+	if validate.MaxAttributes > 0 && attributes > validate.MaxAttributes {
+		issues = append([]issue{newWarning(3112, 3113, attributes, validate.MaxAttributes)}, issues...)
+	}
+
+	return issues
+}
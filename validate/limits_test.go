@@ -0,0 +1,121 @@
+//go:build !windows
+
+package validate_test
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/senzing-garage/validate/validate"
+	"github.com/stretchr/testify/require"
+)
+
+const testLimitData = `{"DATA_SOURCE": "TEST", "RECORD_ID": "1", "NAME_FULL": "Bob Smith", "ADDR_FULL": "1 Main St", "PHONE_NUMBER": "555-1234"}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "2", "ADDRESSES": [{"ADDR_FULL": "Boston"}, {"ADDR_FULL": "Austin"}, {"ADDR_FULL": "Dallas"}]}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "3", "NAME_FULL": "Bartholomew Smith"}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "4", "NAME_FULL": "Bob Smith"}
+`
+
+// ----------------------------------------------------------------------------
+// test record and attribute size limits
+// ----------------------------------------------------------------------------
+
+func TestBasicValidate_Read_limits(test *testing.T) {
+	ctx := test.Context()
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	filename, moreCleanUp := createTempDataFile(test, testLimitData, "jsonl")
+	defer moreCleanUp()
+
+	validator := &validate.BasicValidate{
+		InputURL:        "file://" + filename,
+		MaxAttributes:   4,
+		MaxListElements: 2,
+		MaxRecordBytes:  100,
+		MaxStringLength: 12,
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "Line 1: record is 121 bytes, more than the maximum of 100")
+	require.Contains(test, actual, "Line 1: record has 5 attributes, more than the maximum of 4")
+	require.Contains(test, actual, "Line 2: ADDRESSES has 3 elements, more than the maximum of 2")
+	require.Contains(test, actual, "Line 2: record is 131 bytes, more than the maximum of 100")
+	require.Contains(test, actual, "Line 3: NAME_FULL is 17 characters long, more than the maximum of 12")
+	require.NotContains(test, actual, "Line 4:")
+	require.Contains(test, actual, "2 record(s) were larger than the maximum record size")
+	require.Contains(test, actual, "2 record(s) had more than the maximum number of attributes")
+	require.Contains(test, actual, "1 record(s) had lists with more than the maximum number of elements")
+	require.Contains(test, actual, "1 record(s) had values longer than the maximum length")
+	require.Contains(test, actual, "Validated 4 lines, 0 were bad")
+	require.True(test, result)
+}
+
+// ----------------------------------------------------------------------------
+
+// A record longer than bufio.Scanner's 64 KB default is validated.
+func TestBasicValidate_Read_limits_long_line(test *testing.T) {
+	actual, result := readLongLine(test, &validate.BasicValidate{MaxListElements: 1000, MaxRecordBytes: 1048576})
+
+	require.Contains(test, actual, "Line 2: ADDRESSES has 10000 elements, more than the maximum of 1000")
+	require.NotContains(test, actual, "more than the maximum of 1048576")
+	require.Contains(test, actual, "Validated 3 lines, 0 were bad")
+	require.True(test, result)
+}
+
+// ----------------------------------------------------------------------------
+
+// A record too long to be read is measured, skipped and counted as bad, and the
+// lines after it are validated.
+func TestBasicValidate_Read_limits_line_too_long(test *testing.T) {
+	actual, result := readLongLine(test, &validate.BasicValidate{MaxRecordBytes: 1000})
+
+	require.Regexp(test, `Line 2: record is \d{6} bytes, too large to be validated with a maximum record size of 1000`, actual)
+	require.NotContains(test, actual, "ADDRESSES has")
+	require.Contains(test, actual, "1 record(s) were too large to be validated")
+	require.Contains(test, actual, "Validated 3 lines, 1 were bad")
+	require.True(test, result)
+}
+
+// ----------------------------------------------------------------------------
+// Helper functions
+// ----------------------------------------------------------------------------
+
+// Read a record with 10,000 addresses, of about 500 KB, between two small ones,
+// with the validator and return the output and result.
+func readLongLine(t *testing.T, validator *validate.BasicValidate) (string, bool) {
+	t.Helper()
+
+	reader, writer, cleanUp := mockStdout(t)
+	defer cleanUp()
+
+	addresses := make([]string, 10000)
+	for index := range addresses {
+		addresses[index] = fmt.Sprintf(`{"ADDR_FULL": "%d Main Street, Springfield"}`, index)
+	}
+
+	data := `{"DATA_SOURCE": "TEST", "RECORD_ID": "1"}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "2", "ADDRESSES": [` + strings.Join(addresses, ", ") + `]}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "3"}
+`
+
+	filename, moreCleanUp := createTempDataFile(t, data, "jsonl")
+	defer moreCleanUp()
+
+	validator.InputURL = "file://" + filename
+	result := validator.Read(t.Context())
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+
+	return string(out), result
+}
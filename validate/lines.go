@@ -0,0 +1,145 @@
+package validate
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+)
+
+// Lines up to this many times MaxRecordBytes are read and validated; longer
+// ones are only measured.
+const lineLimitFactor = 4
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// lineReader reads lines as bufio.Scanner does, less their LF or CRLF ending,
+// but a line longer than its limit is skipped and measured rather than held in
+// memory or stopping the read.
+type lineReader struct {
+	err       error
+	isCRLF    bool
+	isTooLong bool
+	limit     int
+	line      []byte
+	reader    *bufio.Reader
+	size      int
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// The longest line ValidateLines reads to validate: lineLimitFactor times
+// MaxRecordBytes, but at least bufio.MaxScanTokenSize.  There is no limit if
+// MaxRecordBytes is 0, or if records are written out, so none are lost.
+func (validate *BasicValidate) lineLimit() int {
+	if validate.MaxRecordBytes <= 0 || validate.fixOutput != nil || validate.normalizedOutput != nil {
+		return 0
+	}
+
+	return max(lineLimitFactor*validate.MaxRecordBytes, bufio.MaxScanTokenSize)
+}
+
+// ----------------------------------------------------------------------------
+
+// Report a line too long to be read as a bad record, as it was not validated.
+func (validate *BasicValidate) validateTooLongLine(counts *recordCounts, location string, size int) {
+	validate.reportIssues(counts, location, []issue{newError(3118, 3119, size, validate.MaxRecordBytes)})
+
+	counts.records++
+	counts.badLines++
+
+	validate.reportProgress(counts)
+}
+
+// ----------------------------------------------------------------------------
+// Methods for lineReader
+// ----------------------------------------------------------------------------
+
+// Read the next line.  Returns false at the end of the input, or if reading
+// failed, when err is set.
+func (lines *lineReader) scan() bool {
+	lines.line = lines.line[:0]
+	lines.isCRLF = false
+	lines.isTooLong = false
+	lines.size = 0
+
+	var previous byte
+
+	for {
+		chunk, err := lines.reader.ReadSlice('\n')
+		lines.size += len(chunk)
+
+		if lines.limit > 0 && len(lines.line)+len(chunk) > lines.limit {
+			lines.line = lines.line[:0]
+			lines.isTooLong = true
+		} else if !lines.isTooLong {
+			lines.line = append(lines.line, chunk...)
+		}
+
+		switch {
+		case err == nil:
+			return lines.endLine(chunk, previous)
+		case errors.Is(err, bufio.ErrBufferFull):
+			previous = chunk[len(chunk)-1]
+		case errors.Is(err, io.EOF):
+			return lines.size > 0 && lines.endLine(chunk, previous)
+		default:
+			lines.err = err
+
+			return false
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+// Remove the line ending from the line read, given its last chunk and the
+// byte before that chunk, noting if it was CRLF.
+func (lines *lineReader) endLine(chunk []byte, previous byte) bool {
+	ending := 0
+
+	if bytes.HasSuffix(chunk, []byte("\n")) {
+		ending = 1
+		lines.isCRLF = bytes.HasSuffix(chunk, []byte("\r\n")) || (len(chunk) == 1 && previous == '\r')
+	}
+
+	if lines.isCRLF {
+		ending = 2
+	}
+
+	lines.size -= ending
+
+	if !lines.isTooLong {
+		lines.line = lines.line[:len(lines.line)-ending]
+	}
+
+	return true
+}
+
+// ----------------------------------------------------------------------------
+
+// The line read, less its line ending.
+func (lines *lineReader) text() string {
+	return string(lines.line)
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// A lineReader skipping lines longer than limit bytes, or none if it is 0.
+func newLineReader(reader io.Reader, limit int) *lineReader {
+	return &lineReader{
+		err:       nil,
+		isCRLF:    false,
+		isTooLong: false,
+		limit:     limit,
+		line:      nil,
+		reader:    bufio.NewReader(reader),
+		size:      0,
+	}
+}
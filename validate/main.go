@@ -32,35 +32,184 @@ const Prefix = "validate: "
 var IDMessages = map[int]string{
 	2200: Prefix + "Validating URL string: %s",
 	2201: Prefix + "Validating as a JSONL file.",
+	2202: Prefix + "Validating as a JSON file.",
 	2203: Prefix + "Validating a GZIP file.",
 	2204: Prefix + "Validating as a JSONL resource.",
 	2205: Prefix + "Validating a GZIP resource.",
+	2206: Prefix + "Validating as a JSONL S3 object.",
+	2207: Prefix + "Validating a GZIP S3 object.",
+	2208: Prefix + "Validating S3 objects with prefix: %s",
+	2209: Prefix + "Validating S3 object: %s",
 	2210: Prefix + "Validated %d lines, %d were bad.",
-	3001: Prefix + "%d line(s) had no RECORD_ID field.",
-	3002: Prefix + "%d line(s) had no DATA_SOURCE field.",
-	3003: Prefix + "%d line(s) are not well formed JSON-lines.",
-	3004: Prefix + "%d line(s) did not validate for an unknown reason.",
-	3005: Prefix + "Line %d: a RECORD_ID field is required",
-	3006: Prefix + "Line %d: a DATA_SOURCE field is required",
-	3007: Prefix + "Line %d: JSON-line not well formed",
-	3008: Prefix + "Line %d: did not validate for an unknown reason",
+	2211: Prefix + "Validated %d JSON records, %d were bad.",
+	2212: Prefix + "Input starts with a JSON array, validating as JSON.",
+	2213: Prefix + "Validating as a JSON resource.",
+	2214: Prefix + "Validating as a JSON S3 object.",
+	2215: Prefix + "Validating as a Parquet file.",
+	2216: Prefix + "Validating as a Parquet resource.",
+	2217: Prefix + "Validated %d Parquet rows, %d were bad.",
+	2218: Prefix + "Input holds more than one JSON object, validating as JSONL.",
+	2220: Prefix + "Retrying %s in %s after attempt %d of %d retries failed: %v",
+	2221: Prefix + "Resuming %s at byte offset %d after: %v",
+	2230: Prefix + "Validating as JSONL from stdin.",
+	2231: Prefix + "Validating GZIP from stdin.",
+	2240: Prefix + "Detected a %s byte order mark.",
+	2250: Prefix + "Writing normalized records to %s.",
+	2260: Prefix + "%d record(s) had RECORD_TYPE %s.",
+	2261: Prefix + "%d record(s) had no RECORD_TYPE.",
+	2270: Prefix + "Writing fixed records to %s.",
+	2271: Prefix + "%s: Trimmed white space from %s.",
+	2272: Prefix + "%s: Renamed %s to %s.",
+	2273: Prefix + "%s: Converted RECORD_ID %s from a number to a string.",
+	2274: Prefix + "%s: Set DATA_SOURCE to %q.",
+	2275: Prefix + "%s: Replaced the CRLF line ending with LF.",
+	2276: Prefix + "Removed the %s byte order mark.",
+	2277: Prefix + "%s: Dropped %s.",
+	2278: Prefix + "%s: Did not rename %s to %s, which is already set.",
+	2280: Prefix + "Sampling a fraction %g of %s with seed %d.",
+	2281: Prefix + "Validated a sample of %d of %d %s read, %d were bad.",
+	2282: Prefix + "Estimated %d of %d %s read are bad, %.2f%% with a 95%% confidence interval of %.2f%% to %.2f%%.",
+	2283: Prefix + "Skipping the first %d %s.",
+	2284: Prefix + "Reading at most %d %s.",
+	2290: Prefix + "Progress: %d records, %d bad, %.0f records/second.",
+	2291: Prefix + "Progress: %d records, %d bad, %.0f records/second, %s read.",
+	2292: Prefix + "Progress: %d records, %d bad, %.0f records/second, %s of %s read (%.1f%%), about %s remaining.",
+	3001: Prefix + "%d record(s) had no RECORD_ID field.",
+	3002: Prefix + "%d record(s) had no DATA_SOURCE field.",
+	3003: Prefix + "%d record(s) were not well formed JSON.",
+	3005: Prefix + "%s: a RECORD_ID field is required",
+	3006: Prefix + "%s: a DATA_SOURCE field is required",
+	3007: Prefix + "%s: record is not well formed JSON",
 	3009: Prefix + "Warning: Unable to set log level to %s, defaulting to INFO",
+	3010: Prefix + "%d record(s) had invalid UTF-8 byte sequences.",
+	3011: Prefix + "%s: invalid UTF-8 byte sequence at byte offset %d of the record",
+	3012: Prefix + "%d record(s) were JSON values other than objects.",
+	3013: Prefix + "%s: record is not a JSON object",
+	3014: Prefix + "%d record(s) had data after the JSON object.",
+	3015: Prefix + "%s: record has data after the JSON object",
+	3016: Prefix + "%d record(s) had an empty DATA_SOURCE field.",
+	3017: Prefix + "%s: the DATA_SOURCE field is empty",
+	3018: Prefix + "%d record(s) had an empty RECORD_ID field.",
+	3019: Prefix + "%s: the RECORD_ID field is empty",
+	3020: Prefix + "%d record(s) had control characters in attribute values.",
+	3021: Prefix + "%s: %s holds control character %U at character %d",
+	3022: Prefix + "%d record(s) had invisible or non-breaking characters in attribute values.",
+	3023: Prefix + "%s: %s holds invisible or non-breaking character %U at character %d",
+	3024: Prefix + "%d record(s) had attribute values not in Unicode Normalization Form C (NFC).",
+	3025: Prefix + "%s: %s is not in Unicode Normalization Form C (NFC)",
+	3030: Prefix + "%d record(s) had impossible dates.",
+	3031: Prefix + "%s: %s is an impossible date: %q",
+	3032: Prefix + "%d record(s) had dates in an unrecognized format.",
+	3033: Prefix + "%s: %s is a date in an unrecognized format: %q",
+	3034: Prefix + "%d record(s) had a date of birth in the future.",
+	3035: Prefix + "%s: %s is in the future: %q",
+	3036: Prefix + "%d record(s) had a date of death before the date of birth.",
+	3037: Prefix + "%s: %s %q is before %s %q",
+	3040: Prefix + "%d record(s) had incomplete addresses.",
+	3041: Prefix + "%s: %s has %s but no ADDR_LINE1 or ADDR_FULL",
+	3042: Prefix + "%d record(s) had addresses combining ADDR_FULL with parsed address attributes.",
+	3043: Prefix + "%s: %s combines ADDR_FULL with %s",
+	3044: Prefix + "%d record(s) had ADDR_COUNTRY values that are not ISO 3166 countries.",
+	3045: Prefix + "%s: %s is not an ISO 3166 country code or name: %q",
+	3050: Prefix + "%d record(s) had name objects mixing NAME_FULL with NAME_FIRST or NAME_LAST.",
+	3051: Prefix + "%s: %s mixes NAME_FULL with %s",
+	3054: Prefix + "%d record(s) had placeholder or punctuation-only names.",
+	3055: Prefix + "%s: %s is a placeholder or punctuation, not a name: %q",
+	3056: Prefix + "%d record(s) had single character names.",
+	3057: Prefix + "%s: %s is a single character name: %q",
+	3060: Prefix + "%d record(s) had identifiers in an invalid format.",
+	3061: Prefix + "%s: %s is not in a valid format: %q",
+	3062: Prefix + "%d record(s) had placeholder identifiers.",
+	3063: Prefix + "%s: %s is a placeholder, not an identifier: %q",
+	3064: Prefix + "%d record(s) had country scoped identifiers without their country.",
+	3065: Prefix + "%s: %s has no %s",
+	3070: Prefix + "%d record(s) had phone numbers that could not be read.",
+	3071: Prefix + "%s: %s is not a phone number: %q",
+	3072: Prefix + "%d record(s) had invalid phone numbers.",
+	3073: Prefix + "%s: %s is not a valid phone number: %q, read as %s",
+	3074: Prefix + "%d record(s) had invalid email addresses.",
+	3075: Prefix + "%s: %s is not a valid email address: %q",
+	3076: Prefix + "%d record(s) had placeholder email addresses.",
+	3077: Prefix + "%s: %s is a placeholder email address: %q",
+	3080: Prefix + "%d record(s) had a RECORD_TYPE that is not allowed.",
+	3081: Prefix + "%s: RECORD_TYPE is not one of %s: %q",
+	3082: Prefix + "%d record(s) had attributes inconsistent with their RECORD_TYPE.",
+	3083: Prefix + "%s: %s is not expected on a record with RECORD_TYPE %s",
+	3090: Prefix + "%d record(s) had empty %s values.",
+	3091: Prefix + "%s: %s is %s",
+	3100: Prefix + "%d record(s) had numbers or booleans where a string is expected.",
+	3101: Prefix + "%s: %s is %s, not a string: %v",
+	3102: Prefix + "%d record(s) had lists holding values other than objects.",
+	3103: Prefix + "%s: %s is %s, not an object",
+	3104: Prefix + "%d record(s) had objects or lists where a string is expected.",
+	3105: Prefix + "%s: %s is %s where a string is expected",
+	3110: Prefix + "%d record(s) were larger than the maximum record size.",
+	3111: Prefix + "%s: record is %d bytes, more than the maximum of %d",
+	3112: Prefix + "%d record(s) had more than the maximum number of attributes.",
+	3113: Prefix + "%s: record has %d attributes, more than the maximum of %d",
+	3114: Prefix + "%d record(s) had lists with more than the maximum number of elements.",
+	3115: Prefix + "%s: %s has %d elements, more than the maximum of %d",
+	3116: Prefix + "%d record(s) had values longer than the maximum length.",
+	3117: Prefix + "%s: %s is %d characters long, more than the maximum of %d",
+	3118: Prefix + "%d record(s) were too large to be validated.",
+	3119: Prefix + "%s: record is %d bytes, too large to be validated with a maximum record size of %d",
+	3120: Prefix + "%d record(s) had keys differing in case or white space from known attributes.",
+	3121: Prefix + "%s: %q is %s in the wrong case or with white space",
 	5000: Prefix + "Fatal error, Check the input-url parameter: %s",
 	5001: Prefix + "Fatal error parsing input-url.",
 	5002: Prefix + "Fatal error unable to handle %s input URLs.",
 	5003: Prefix + "Fatal error retrieving input-url: %s",
 	5004: Prefix + "Fatal error opening input file: %s",
 	5005: Prefix + "Fatal error opening stdin.",
-	5006: Prefix + "Fatal error stdin not piped.",
+	5006: Prefix + "Fatal error stdin not piped or redirected.",
 	5007: Prefix + "Fatal error opening GZIPped file: %s",
 	5008: Prefix + "Fatal error reading GZIPped file: %s",
 	5009: Prefix + "Fatal error retrieving GZIPped input-url: %s",
 	5010: Prefix + "Fatal error reading GZIPped input-url: %s",
 	5011: Prefix + "If this is a valid JSONL file, please rename with the .jsonl extension or use the file type override (--file-type).",
 	5012: Prefix + "If this is a valid JSONL resource, please rename with the .jsonl extension or use the file type override (--file-type).",
+	5013: Prefix + "Fatal error configuring S3 client.",
+	5014: Prefix + "Fatal error retrieving S3 object: %s",
+	5015: Prefix + "Fatal error reading GZIPped S3 object: %s",
+	5016: Prefix + "Fatal error listing S3 objects with prefix: %s",
+	5017: Prefix + "Fatal error no JSONL, JSON or GZIP S3 objects found with prefix: %s",
+	5018: Prefix + "If this is a valid JSONL S3 object, please rename with the .jsonl extension or use the file type override (--input-file-type).",
+	5019: Prefix + "Fatal error retrieving input-url: %s returned HTTP status %s",
+	5020: Prefix + "Fatal error configuring HTTP client: %v",
+	5021: Prefix + "Fatal error reading input after line %d: %v",
+	5022: Prefix + "Fatal error reading GZIPped stdin.",
+	5023: Prefix + "Fatal error input is not a JSON array or object at byte offset %d.",
+	5024: Prefix + "Fatal error parsing JSON array element %d near byte offset %d: %v",
+	5025: Prefix + "Fatal error opening Parquet file: %s",
+	5026: Prefix + "Fatal error reading Parquet input: %v",
+	5027: Prefix + "Fatal error reading Parquet row group %d, row %d: %v",
+	5028: Prefix + "Fatal error retrieving input-url: %s does not support the HTTP range requests needed to read Parquet.",
+	5029: Prefix + "Fatal error unsupported input-encoding: %s",
+	5030: Prefix + "Fatal error creating output file: %s",
+	5031: Prefix + "Fatal error writing output file: %s",
+	5032: Prefix + "Fatal error unsupported default-phone-region: %s",
+	5033: Prefix + "Fatal error unsupported repair: %s; repairs are %s",
+	5034: Prefix + "Fatal error fix requires an output-file.",
+	5035: Prefix + "Fatal error rename is not in KEY=NEWKEY form: %s",
+	5036: Prefix + "Fatal error sample-rate must be a fraction from 0 to 1: %g",
+	5037: Prefix + "Fatal error head and skip must not be negative: %d, %d",
+	5038: Prefix + "Canceled after reading %d %s, so the summary is of those only: %v",
+	5039: Prefix + "Fatal error fix writes every record, so head, skip and sample-rate cannot be used.",
+	5040: Prefix + "Fatal error data after the JSON %s near byte offset %d: %v",
 }
 
 // Status strings for specific messages.
 var IDStatuses = map[int]string{}
 
-var errForPackage = errors.New("validate")
+var (
+	errForPackage            = errors.New("validate")
+	errImpossibleDate        = errors.New("impossible date")
+	errRecordEmptyDataSource = errors.New("DATA_SOURCE is empty")
+	errRecordEmptyRecordID   = errors.New("RECORD_ID is empty")
+	errRecordMalformed       = errors.New("record is not well formed JSON")
+	errRecordNoDataSource    = errors.New("record has no DATA_SOURCE")
+	errRecordNoRecordID      = errors.New("record has no RECORD_ID")
+	errRecordNotObject       = errors.New("record is not a JSON object")
+	errRecordTrailingData    = errors.New("unexpected data after the record")
+	errUnrecognizedDate      = errors.New("unrecognized date format")
+)
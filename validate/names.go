@@ -0,0 +1,128 @@
+package validate
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Name attributes, optionally prefixed by a usage type such as "PRIMARY_".
+var nameAttribute = regexp.MustCompile(`^(.*_)?NAME_(FULL|ORG|LAST|FIRST|MIDDLE|PREFIX|SUFFIX)$`)
+
+// Values commonly entered when a name is not known, used when JunkNames is not
+// set.
+var defaultJunkNames = []string{
+	"ANONYMOUS",
+	"DUMMY",
+	"N/A",
+	"NA",
+	"NIL",
+	"NO NAME",
+	"NONAME",
+	"NONE",
+	"NOT APPLICABLE",
+	"NOT AVAILABLE",
+	"NOT PROVIDED",
+	"NULL",
+	"SAMPLE",
+	"TBD",
+	"TEST",
+	"TESTING",
+	"UNK",
+	"UNKNOWN",
+	"XXX",
+	"XXXX",
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Report name objects that mix NAME_FULL with NAME_FIRST or NAME_LAST, and
+// names that are placeholders, punctuation or a single character.  Each object,
+// and each usage type prefix within it, is a separate name.
+func (validate *BasicValidate) checkNames(object jsonObject) []issue {
+	var issues []issue
+
+	walkJSON(object, "", func(path string, value any) {
+		nameObject, isObject := value.(jsonObject)
+		if !isObject {
+			return
+		}
+
+		for _, group := range attributeGroups(path, nameObject, nameAttribute, "NAME_") {
+			parsed := slices.DeleteFunc(slices.Clone(group.attributes), func(attribute string) bool {
+				return attribute != "FIRST" && attribute != "LAST"
+			})
+
+			if slices.Contains(group.attributes, "FULL") && len(parsed) > 0 {
+				issues = append(issues, newWarning(3050, 3051, group.label, group.list(parsed)))
+			}
+		}
+
+		for _, member := range nameObject {
+			match := nameAttribute.FindStringSubmatch(member.Key)
+			name, isString := member.Value.(string)
+
+			if match == nil || !isString || strings.TrimSpace(name) == "" {
+				continue
+			}
+
+			switch {
+			case validate.isJunkName(name):
+				issues = append(issues, newWarning(3054, 3055, joinJSONPath(path, member.Key), name))
+			case isSingleCharacterName(match[2], name):
+				issues = append(issues, newWarning(3056, 3057, joinJSONPath(path, member.Key), name))
+			}
+		}
+	})
+
+	return issues
+}
+
+// ----------------------------------------------------------------------------
+
+// A name is junk if it is entirely punctuation, symbols and spaces, or is one
+// of JunkNames (or defaultJunkNames), ignoring case and extra spaces.
+func (validate *BasicValidate) isJunkName(name string) bool {
+	if !strings.ContainsFunc(name, func(char rune) bool { return unicode.IsLetter(char) || unicode.IsDigit(char) }) {
+		return true
+	}
+
+	if validate.junkNames == nil {
+		junkNames := validate.JunkNames
+		if len(junkNames) == 0 {
+			junkNames = defaultJunkNames
+		}
+
+		validate.junkNames = map[string]bool{}
+		for _, junkName := range junkNames {
+			validate.junkNames[normalizeName(junkName)] = true
+		}
+	}
+
+	return validate.junkNames[normalizeName(name)]
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Middle names, prefixes and suffixes are often initials or abbreviations, so
+// only other single character names are reported.
+func isSingleCharacterName(attribute string, name string) bool {
+	switch attribute {
+	case "MIDDLE", "PREFIX", "SUFFIX":
+		return false
+	default:
+		return utf8.RuneCountInString(strings.TrimSpace(name)) == 1
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+func normalizeName(name string) string {
+	return strings.Join(strings.Fields(strings.ToUpper(name)), " ")
+}
@@ -0,0 +1,82 @@
+//go:build !windows
+
+package validate_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/senzing-garage/validate/validate"
+	"github.com/stretchr/testify/require"
+)
+
+const testNameData = `{"DATA_SOURCE": "TEST", "RECORD_ID": "1", "RECORD_TYPE": "PERSON", "NAMES": [{"NAME_FULL": "Bob Smith", "NAME_LAST": "Smith"}, {"NAME_ORG": "Acme"}]}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "2", "NAME_LAST": "Unknown", "NAME_FIRST": "n/a"}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "3", "NAME_FULL": "--- ..."}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "4", "NAME_LAST": "X", "NAME_FIRST": "Jane", "NAME_MIDDLE": "Q"}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "5", "NAME_ORG": "Acme Corp", "PRIMARY_NAME_FULL": "Acme", "PRIMARY_NAME_FIRST": "Bob"}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "6", "RECORD_TYPE": "ORGANIZATION", "NAME_ORG": "Acme Corp"}
+`
+
+// ----------------------------------------------------------------------------
+// test name attribute validation
+// ----------------------------------------------------------------------------
+
+func TestBasicValidate_Read_names(test *testing.T) {
+	actual, result := readNames(test, nil)
+
+	require.Contains(test, actual, "Line 1: NAMES[0].NAME_* mixes NAME_FULL with NAME_LAST")
+	require.Contains(test, actual, "Line 1: NAMES[1].NAME_ORG is not expected on a record with RECORD_TYPE PERSON")
+	require.Contains(test, actual, `Line 2: NAME_LAST is a placeholder or punctuation, not a name: "Unknown"`)
+	require.Contains(test, actual, `Line 2: NAME_FIRST is a placeholder or punctuation, not a name: "n/a"`)
+	require.Contains(test, actual, `Line 3: NAME_FULL is a placeholder or punctuation, not a name: "--- ..."`)
+	require.Contains(test, actual, `Line 4: NAME_LAST is a single character name: "X"`)
+	require.NotContains(test, actual, "NAME_MIDDLE")
+	require.Contains(test, actual, "Line 5: PRIMARY_NAME_* mixes NAME_FULL with PRIMARY_NAME_FIRST")
+	require.NotContains(test, actual, "Line 6:")
+	require.Contains(test, actual, "2 record(s) had name objects mixing NAME_FULL with NAME_FIRST or NAME_LAST")
+	require.Contains(test, actual, "1 record(s) had attributes inconsistent with their RECORD_TYPE")
+	require.Contains(test, actual, "2 record(s) had placeholder or punctuation-only names")
+	require.Contains(test, actual, "1 record(s) had single character names")
+	require.Contains(test, actual, "Validated 6 lines, 0 were bad")
+	require.True(test, result)
+}
+
+// JunkNames replaces the built-in list of placeholder names.
+func TestBasicValidate_Read_names_junk_names(test *testing.T) {
+	actual, result := readNames(test, []string{"acme", "  bob   smith "})
+
+	require.NotContains(test, actual, `"Unknown"`)
+	require.Contains(test, actual, `Line 1: NAMES[0].NAME_FULL is a placeholder or punctuation, not a name: "Bob Smith"`)
+	require.Contains(test, actual, `Line 1: NAMES[1].NAME_ORG is a placeholder or punctuation, not a name: "Acme"`)
+	require.Contains(test, actual, `Line 3: NAME_FULL is a placeholder or punctuation, not a name: "--- ..."`)
+	require.Contains(test, actual, "3 record(s) had placeholder or punctuation-only names")
+	require.True(test, result)
+}
+
+// ----------------------------------------------------------------------------
+// Helper functions
+// ----------------------------------------------------------------------------
+
+// read testNameData with the given junk names and return the output and result.
+func readNames(t *testing.T, junkNames []string) (string, bool) {
+	t.Helper()
+
+	reader, writer, cleanUp := mockStdout(t)
+	defer cleanUp()
+
+	filename, moreCleanUp := createTempDataFile(t, testNameData, "jsonl")
+	defer moreCleanUp()
+
+	validator := &validate.BasicValidate{
+		InputURL:  "file://" + filename,
+		JunkNames: junkNames,
+	}
+	result := validator.Read(t.Context())
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+
+	return string(out), result
+}
@@ -0,0 +1,164 @@
+package validate
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+
+	"github.com/senzing-garage/go-helpers/wraperror"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// outputFile is a JSONL file written with one record per line, ended with
+// CRLF if isCRLF is set.  The first error writing it is kept and reported when
+// it is closed.
+type outputFile struct {
+	err    error
+	file   *os.File
+	isCRLF bool
+	name   string
+	writer *bufio.Writer
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Create the output files that are set: NormalizedOutputFile and
+// FixOutputFile.
+func (validate *BasicValidate) openOutputs() bool {
+	if validate.NormalizedOutputFile != "" {
+		validate.normalizedOutput = validate.createOutput(validate.NormalizedOutputFile)
+		if validate.normalizedOutput == nil {
+			return false
+		}
+
+		validate.log(2250, validate.NormalizedOutputFile)
+	}
+
+	if validate.FixOutputFile != "" {
+		validate.fixOutput = validate.createOutput(validate.FixOutputFile)
+		if validate.fixOutput == nil {
+			return false
+		}
+
+		validate.log(2270, validate.FixOutputFile)
+	}
+
+	return true
+}
+
+// ----------------------------------------------------------------------------
+
+// Flush and close the output files that are open, reporting any error writing
+// them.
+func (validate *BasicValidate) closeOutputs() bool {
+	isClosed := validate.closeOutput(validate.normalizedOutput)
+	isClosed = validate.closeOutput(validate.fixOutput) && isClosed
+
+	validate.normalizedOutput = nil
+	validate.fixOutput = nil
+
+	return isClosed
+}
+
+// ----------------------------------------------------------------------------
+
+func (validate *BasicValidate) createOutput(name string) *outputFile {
+	file, err := os.Create(filepath.Clean(name))
+	if err != nil {
+		validate.log(5030, name, err)
+
+		return nil
+	}
+
+	return &outputFile{err: nil, file: file, isCRLF: false, name: name, writer: bufio.NewWriter(file)}
+}
+
+// ----------------------------------------------------------------------------
+
+func (validate *BasicValidate) closeOutput(output *outputFile) bool {
+	if output == nil {
+		return true
+	}
+
+	err := output.err
+	if err == nil {
+		err = output.writer.Flush()
+	}
+
+	closeErr := output.file.Close()
+	if err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		validate.log(5031, output.name, err)
+
+		return false
+	}
+
+	return true
+}
+
+// ----------------------------------------------------------------------------
+
+// Write a record to NormalizedOutputFile, as a line of JSONL, with its string
+// values normalized.  A record that could not be parsed is written unchanged.
+func (validate *BasicValidate) writeNormalized(str string, object jsonObject) {
+	if validate.normalizedOutput == nil {
+		return
+	}
+
+	if object == nil {
+		validate.normalizedOutput.writeLine([]byte(str))
+
+		return
+	}
+
+	normalized, err := marshalJSON(transformStrings(object, normalizeString))
+	if err != nil {
+		validate.normalizedOutput.fail(err)
+
+		return
+	}
+
+	validate.normalizedOutput.writeLine(normalized)
+}
+
+// ----------------------------------------------------------------------------
+// Methods for outputFile
+// ----------------------------------------------------------------------------
+
+// Keep the first error writing the file.
+func (output *outputFile) fail(err error) {
+	if output.err == nil {
+		output.err = wraperror.Errorf(err, "writing %s", output.name)
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+func (output *outputFile) write(data []byte) {
+	if output.err != nil {
+		return
+	}
+
+	_, err := output.writer.Write(data)
+	if err != nil {
+		output.fail(err)
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+func (output *outputFile) writeLine(line []byte) {
+	if output.isCRLF {
+		output.write(append(line, '\r', '\n'))
+	} else {
+		output.write(append(line, '\n'))
+	}
+}
@@ -0,0 +1,165 @@
+package validate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/parquet-go/parquet-go"
+)
+
+// Parquet column chunks are read in blocks of this size, which also bounds
+// the number of Range requests made when reading over HTTP.
+const parquetReadBufferSize = 1024 * 1024
+
+// ----------------------------------------------------------------------------
+// Public methods
+// ----------------------------------------------------------------------------
+
+// opens and reads a Parquet file.
+func (validate *BasicValidate) ReadParquetFile(ctx context.Context, parquetFile string) bool {
+	parquetFile = filepath.Clean(parquetFile)
+
+	file, err := os.Open(parquetFile)
+	if err != nil {
+		validate.log(5025, parquetFile, err)
+
+		return false
+	}
+
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		validate.log(5025, parquetFile, err)
+
+		return false
+	}
+
+	return validate.ValidateParquet(ctx, file, info.Size())
+}
+
+// ----------------------------------------------------------------------------
+
+// opens and reads a Parquet resource using HTTP Range requests.
+func (validate *BasicValidate) ReadParquetResource(ctx context.Context, parquetURL string) bool {
+	reader, isOK := validate.openHTTPRangeReader(ctx, parquetURL, 5003)
+	if !isOK {
+		return false
+	}
+
+	return validate.ValidateParquet(ctx, reader, reader.size)
+}
+
+// ----------------------------------------------------------------------------
+
+// validate that each row of a Parquet file is a valid record, or only the rows
+// selected by Skip, Head and SampleRate.  Each row is
+// mapped to a record whose attributes are the row's columns; null columns are
+// omitted.  Rows are identified by row group and row index within the group.
+// Returns false if a row could not be read, or the context was canceled,
+// before all rows were read.
+func (validate *BasicValidate) ValidateParquet(ctx context.Context, reader io.ReaderAt, size int64) bool {
+	file, err := parquet.OpenFile(
+		reader,
+		size,
+		parquet.SkipPageIndex(true),
+		parquet.SkipBloomFilters(true),
+		parquet.ReadBufferSize(parquetReadBufferSize),
+	)
+	if err != nil {
+		validate.log(5026, err)
+
+		return false
+	}
+
+	counts := recordCounts{}
+	sample := validate.newRecordSample("Parquet rows", 2217)
+	totalRows := 0
+
+groups:
+	for group, rowGroup := range file.RowGroups() {
+		rows := parquet.NewRowGroupReader(rowGroup)
+
+		for row := 0; ; row++ {
+			if sample.isDone(totalRows + 1) {
+				rows.Close()
+
+				break groups
+			}
+
+			if validate.logCanceled(ctx, sample.read, "Parquet rows") {
+				rows.Close()
+				validate.logCounts(counts)
+				validate.logSample(sample, counts.bad())
+
+				return false
+			}
+
+			values := map[string]any{}
+
+			err = rows.Read(&values)
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			if err != nil {
+				rows.Close()
+				validate.logCounts(counts)
+				validate.logSample(sample, counts.bad())
+				validate.log(5027, group, row, err)
+
+				return false
+			}
+
+			totalRows++
+			if !sample.isSelected(totalRows) {
+				continue
+			}
+
+			location := fmt.Sprintf("Row group %d, row %d", group, row)
+			validate.validateRecord(&counts, location, parquetRecord(values))
+		}
+
+		rows.Close()
+	}
+
+	validate.logCounts(counts)
+	validate.logSample(sample, counts.bad())
+
+	return true
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+func (validate *BasicValidate) isParquet(path string) bool {
+	return strings.HasSuffix(path, "parquet") || strings.ToUpper(validate.InputFileType) == "PARQUET"
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Convert a Parquet row to a JSON record.  A row that cannot be represented as
+// JSON, e.g. one holding a NaN, is returned as an empty, malformed record.
+func parquetRecord(values map[string]any) string {
+	for column, value := range values {
+		if value == nil {
+			delete(values, column)
+		}
+	}
+
+	result, err := json.Marshal(values)
+	if err != nil {
+		return ""
+	}
+
+	return string(result)
+}
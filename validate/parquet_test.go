@@ -0,0 +1,239 @@
+//go:build !windows
+
+package validate_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/senzing-garage/validate/validate"
+	"github.com/stretchr/testify/require"
+)
+
+const expected5good1bad = "Validated 5 Parquet rows, 1 were bad"
+
+type testParquetRecord struct {
+	DataSource string  `parquet:"DATA_SOURCE"`
+	RecordID   *string `parquet:"RECORD_ID,optional"`
+	NameFull   string  `parquet:"NAME_FULL"`
+}
+
+// ----------------------------------------------------------------------------
+// test Read Parquet
+// ----------------------------------------------------------------------------
+
+// read a .parquet file, reporting the row group and row of the bad record.
+func TestBasicValidate_Read_parquet_file(test *testing.T) {
+	ctx := test.Context()
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	filename, moreCleanUp := createTempDataFile(test, string(testParquetData(test)), "parquet")
+	defer moreCleanUp()
+
+	validator := &validate.BasicValidate{
+		InputURL: "file://" + filename,
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "Validating as a Parquet file")
+	require.Contains(test, actual, "Row group 1, row 1")
+	require.Contains(test, actual, expected5good1bad)
+	require.True(test, result)
+}
+
+// read only the rows selected by Skip and Head, across row groups.
+func TestBasicValidate_Read_parquet_head_and_skip(test *testing.T) {
+	ctx := test.Context()
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	filename, moreCleanUp := createTempDataFile(test, string(testParquetData(test)), "parquet")
+	defer moreCleanUp()
+
+	validator := &validate.BasicValidate{
+		Head:     1,
+		InputURL: "file://" + filename,
+		Skip:     3,
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "Skipping the first 3 Parquet rows")
+	require.Contains(test, actual, "Row group 1, row 1")
+	require.Contains(test, actual, "Validated 1 Parquet rows, 1 were bad")
+	require.True(test, result)
+}
+
+// read a Parquet file with another extension using the file type override.
+func TestBasicValidate_Read_parquet_override_file_type(test *testing.T) {
+	ctx := test.Context()
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	filename, moreCleanUp := createTempDataFile(test, string(testParquetData(test)), "dat")
+	defer moreCleanUp()
+
+	validator := &validate.BasicValidate{
+		InputFileType: "parquet",
+		InputURL:      "file://" + filename,
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, expected5good1bad)
+	require.True(test, result)
+}
+
+func TestBasicValidate_Read_parquet_not_parquet(test *testing.T) {
+	ctx := test.Context()
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	filename, moreCleanUp := createTempDataFile(test, testGoodData, "parquet")
+	defer moreCleanUp()
+
+	validator := &validate.BasicValidate{
+		InputURL: "file://" + filename,
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "Fatal error reading Parquet input")
+	require.False(test, result)
+}
+
+func TestBasicValidate_Read_parquet_file_does_not_exist(test *testing.T) {
+	ctx := test.Context()
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	validator := &validate.BasicValidate{
+		InputURL: "file:///does/not/exist.parquet",
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "Fatal error opening Parquet file: /does/not/exist.parquet")
+	require.False(test, result)
+}
+
+// read a Parquet resource with Range requests.
+func TestBasicValidate_Read_resource_parquet(test *testing.T) {
+	ctx := test.Context()
+	content := testParquetData(test)
+
+	var rangeRequests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.Header.Get("Range") != "" {
+			rangeRequests.Add(1)
+		}
+
+		http.ServeContent(writer, request, "data.parquet", time.Time{}, bytes.NewReader(content))
+	}))
+	defer server.Close()
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	validator := &validate.BasicValidate{
+		InputURL: server.URL + "/data.parquet",
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "Validating as a Parquet resource")
+	require.Contains(test, actual, "Row group 1, row 1")
+	require.Contains(test, actual, expected5good1bad)
+	require.Greater(test, rangeRequests.Load(), int32(1))
+	require.True(test, result)
+}
+
+func TestBasicValidate_Read_resource_parquet_ranges_not_supported(test *testing.T) {
+	ctx := test.Context()
+	content := testParquetData(test)
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		_, _ = writer.Write(content)
+	}))
+	defer server.Close()
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	validator := &validate.BasicValidate{
+		InputURL: server.URL + "/data.parquet",
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "does not support the HTTP range requests needed to read Parquet")
+	require.False(test, result)
+}
+
+// ----------------------------------------------------------------------------
+// Helper functions
+// ----------------------------------------------------------------------------
+
+// return a Parquet file of five records in row groups of two rows.  The second
+// row of the second row group has no RECORD_ID.
+func testParquetData(t *testing.T) []byte {
+	t.Helper()
+
+	recordID := func(id string) *string { return &id }
+	rows := []testParquetRecord{
+		{DataSource: "TEST", RecordID: recordID("1"), NameFull: "Robert Smith"},
+		{DataSource: "TEST", RecordID: recordID("2"), NameFull: "Bob Smith"},
+		{DataSource: "TEST", RecordID: recordID("3"), NameFull: "Mary Jones"},
+		{DataSource: "TEST", RecordID: nil, NameFull: "Jane Doe"},
+		{DataSource: "TEST", RecordID: recordID("5"), NameFull: "John Doe"},
+	}
+
+	var buffer bytes.Buffer
+
+	err := parquet.Write(&buffer, rows, parquet.MaxRowsPerRowGroup(2))
+	require.NoError(t, err)
+
+	return buffer.Bytes()
+}
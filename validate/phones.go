@@ -0,0 +1,60 @@
+package validate
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/nyaruka/phonenumbers"
+)
+
+// Phone number attributes, optionally prefixed by a usage type such as "CELL_".
+var phoneAttribute = regexp.MustCompile(`^(.*_)?PHONE_NUMBER$`)
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Report phone numbers that cannot be read as a phone number, or that are read
+// as a number that cannot exist, with the E.164 form the number was read as.
+// Numbers not in international format are read as numbers of
+// DefaultPhoneRegion, and are not checked if it is not set.
+func (validate *BasicValidate) checkPhones(object jsonObject) []issue {
+	var issues []issue
+
+	region := strings.ToUpper(validate.DefaultPhoneRegion)
+
+	walkJSON(object, "", func(path string, value any) {
+		phone, isString := value.(string)
+		if !isString || !phoneAttribute.MatchString(attributeName(path)) || strings.TrimSpace(phone) == "" {
+			return
+		}
+
+		number, err := phonenumbers.Parse(phone, region)
+
+		switch {
+		case errors.Is(err, phonenumbers.ErrInvalidCountryCode) && region == "" &&
+			!strings.HasPrefix(strings.TrimSpace(phone), "+"):
+		case err != nil:
+			issues = append(issues, newWarning(3070, 3071, path, phone))
+		case !phonenumbers.IsValidNumber(number):
+			issues = append(issues, newWarning(3072, 3073, path, phone, phonenumbers.Format(number, phonenumbers.E164)))
+		}
+	})
+
+	return issues
+}
+
+// ----------------------------------------------------------------------------
+
+// Check that DefaultPhoneRegion, if set, is a region phone numbers can be read
+// for.
+func (validate *BasicValidate) isDefaultPhoneRegionSupported() bool {
+	if validate.DefaultPhoneRegion == "" || phonenumbers.GetSupportedRegions()[strings.ToUpper(validate.DefaultPhoneRegion)] {
+		return true
+	}
+
+	validate.log(5032, validate.DefaultPhoneRegion)
+
+	return false
+}
@@ -0,0 +1,87 @@
+//go:build !windows
+
+package validate_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/senzing-garage/validate/validate"
+	"github.com/stretchr/testify/require"
+)
+
+const testPhoneData = `{"DATA_SOURCE": "TEST", "RECORD_ID": "1", "PHONE_NUMBER": "call me"}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "2", "PHONE_NUMBER": "+1 123 456 7890"}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "3", "PHONE_NUMBER": "(202) 456-1111"}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "4", "PHONES": [{"CELL_PHONE_NUMBER": "555 1234"}]}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "5", "PHONE_NUMBER": "+44 20 7946 0000"}
+`
+
+// ----------------------------------------------------------------------------
+// test phone number validation
+// ----------------------------------------------------------------------------
+
+// Without DefaultPhoneRegion only numbers in international format are checked.
+func TestBasicValidate_Read_phones(test *testing.T) {
+	actual, result := readPhones(test, "")
+
+	require.Contains(test, actual, `Line 1: PHONE_NUMBER is not a phone number: "call me"`)
+	require.Contains(test, actual, `Line 2: PHONE_NUMBER is not a valid phone number: "+1 123 456 7890", read as +11234567890`)
+	require.NotContains(test, actual, "Line 3:")
+	require.NotContains(test, actual, "Line 4:")
+	require.NotContains(test, actual, "Line 5:")
+	require.Contains(test, actual, "1 record(s) had phone numbers that could not be read")
+	require.Contains(test, actual, "1 record(s) had invalid phone numbers")
+	require.Contains(test, actual, "Validated 5 lines, 0 were bad")
+	require.True(test, result)
+}
+
+// ----------------------------------------------------------------------------
+
+func TestBasicValidate_Read_phones_default_phone_region(test *testing.T) {
+	actual, result := readPhones(test, "us")
+
+	require.NotContains(test, actual, "Line 3:")
+	require.Contains(test, actual, `Line 4: PHONES[0].CELL_PHONE_NUMBER is not a valid phone number: "555 1234", read as +15551234`)
+	require.NotContains(test, actual, "Line 5:")
+	require.Contains(test, actual, "2 record(s) had invalid phone numbers")
+	require.True(test, result)
+}
+
+// ----------------------------------------------------------------------------
+
+func TestBasicValidate_Read_phones_default_phone_region_unsupported(test *testing.T) {
+	actual, result := readPhones(test, "XX")
+
+	require.Contains(test, actual, "Fatal error unsupported default-phone-region: XX")
+	require.NotContains(test, actual, "Validated")
+	require.False(test, result)
+}
+
+// ----------------------------------------------------------------------------
+// Helper functions
+// ----------------------------------------------------------------------------
+
+// read testPhoneData with the given default phone region and return the output
+// and result.
+func readPhones(t *testing.T, defaultPhoneRegion string) (string, bool) {
+	t.Helper()
+
+	reader, writer, cleanUp := mockStdout(t)
+	defer cleanUp()
+
+	filename, moreCleanUp := createTempDataFile(t, testPhoneData, "jsonl")
+	defer moreCleanUp()
+
+	validator := &validate.BasicValidate{
+		DefaultPhoneRegion: defaultPhoneRegion,
+		InputURL:           "file://" + filename,
+	}
+	result := validator.Read(t.Context())
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+
+	return string(out), result
+}
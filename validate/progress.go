@@ -0,0 +1,146 @@
+package validate
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// progress tracks a long running validation so it can be reported every
+// ProgressInterval.  Bytes are those read from the current input, before any
+// decompression, out of totalBytes if its size is known.
+type progress struct {
+	bytesRead  int64
+	lastReport time.Time
+	start      time.Time
+	totalBytes int64
+}
+
+// countingReader counts the bytes read through it in a progress.
+type countingReader struct {
+	progress *progress
+	reader   io.Reader
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Count the bytes read from an input of the given size, or 0 if the size is not
+// known, when reporting progress.  Progress is reported from the start of each
+// input.
+func (validate *BasicValidate) countBytes(reader io.Reader, size int64) io.Reader {
+	if validate.progress == nil {
+		return reader
+	}
+
+	validate.progress.bytesRead = 0
+	validate.progress.lastReport = time.Now()
+	validate.progress.start = validate.progress.lastReport
+	validate.progress.totalBytes = max(0, size)
+
+	return &countingReader{progress: validate.progress, reader: reader}
+}
+
+// ----------------------------------------------------------------------------
+
+// Log a progress message: to stderr, so it is kept apart from the results, or
+// as a structured message if JSONOutput is set.
+func (validate *BasicValidate) logProgress(messageNumber int, details ...any) {
+	if validate.JSONOutput {
+		validate.getLogger().Log(messageNumber, details...)
+	} else {
+		fmt.Fprintln(os.Stderr, fmt.Sprintf(IDMessages[messageNumber], details...)) //nolint
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+// Report progress if ProgressInterval has passed since it was last reported:
+// the records validated, how many were bad and how fast they are read, and,
+// when known, the bytes read and the time remaining.
+func (validate *BasicValidate) reportProgress(counts *recordCounts) {
+	if validate.progress == nil || time.Since(validate.progress.lastReport) < validate.ProgressInterval {
+		return
+	}
+
+	current := validate.progress
+	current.lastReport = time.Now()
+	elapsed := current.lastReport.Sub(current.start)
+	rate := float64(counts.records) / max(elapsed.Seconds(), 1e-9)
+
+	switch {
+	case current.totalBytes > 0 && current.bytesRead > 0:
+		remaining := time.Duration(float64(elapsed) * float64(current.totalBytes-current.bytesRead) / float64(current.bytesRead))
+		validate.logProgress(2292, counts.records, counts.bad(), rate, formatBytes(current.bytesRead),
+			formatBytes(current.totalBytes), 100*float64(current.bytesRead)/float64(current.totalBytes),
+			remaining.Round(time.Second))
+	case current.bytesRead > 0:
+		validate.logProgress(2291, counts.records, counts.bad(), rate, formatBytes(current.bytesRead))
+	default:
+		validate.logProgress(2290, counts.records, counts.bad(), rate)
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+// Start tracking progress if ProgressInterval is set.
+func (validate *BasicValidate) startProgress() {
+	validate.progress = nil
+
+	if validate.ProgressInterval > 0 {
+		now := time.Now()
+		validate.progress = &progress{bytesRead: 0, lastReport: now, start: now, totalBytes: 0}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Methods for countingReader
+// ----------------------------------------------------------------------------
+
+func (reader *countingReader) Read(buffer []byte) (int, error) {
+	count, err := reader.reader.Read(buffer)
+	reader.progress.bytesRead += int64(count)
+
+	return count, err //nolint:wrapcheck
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// A byte count in the largest unit it has at least one of, e.g. "1.5 GB".
+func formatBytes(count int64) string {
+	const unit = 1000
+
+	if count < unit {
+		return fmt.Sprintf("%d B", count)
+	}
+
+	value := float64(count)
+	suffix := 0
+
+	for value >= unit && suffix < len("KMGTPE") {
+		value /= unit
+		suffix++
+	}
+
+	return fmt.Sprintf("%.1f %cB", value, "KMGTPE"[suffix-1])
+}
+
+// ----------------------------------------------------------------------------
+
+// The size of a regular file, or 0 if it is not one, such as a pipe.
+func fileSize(file *os.File) int64 {
+	info, err := file.Stat()
+	if err != nil || !info.Mode().IsRegular() {
+		return 0
+	}
+
+	return info.Size()
+}
@@ -0,0 +1,77 @@
+//go:build !windows
+
+package validate_test
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/senzing-garage/validate/validate"
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------------------------------------------------------------
+// test progress
+// ----------------------------------------------------------------------------
+
+func TestBasicValidate_Read_progress(test *testing.T) {
+	actual, progress := readWithProgress(test, &validate.BasicValidate{ProgressInterval: time.Nanosecond})
+
+	require.Contains(test, progress, "Progress: 1 records, 0 bad")
+	require.Contains(test, progress, "Progress: 16 records, 4 bad")
+	require.Contains(test, progress, "records/second")
+	require.Regexp(test, `read \(\d+\.\d%\), about \d+s remaining`, progress)
+	require.NotContains(test, actual, "Progress")
+	require.Contains(test, actual, expected16good4bad)
+}
+
+// ----------------------------------------------------------------------------
+
+func TestBasicValidate_Read_progress_json_output(test *testing.T) {
+	actual, progress := readWithProgress(test, &validate.BasicValidate{
+		JSONOutput:       true,
+		ProgressInterval: time.Nanosecond,
+	})
+
+	require.Contains(test, actual+progress, `"id":"SZTL62032292"`)
+	require.NotContains(test, progress, "Progress:")
+}
+
+// ----------------------------------------------------------------------------
+
+func TestBasicValidate_Read_no_progress(test *testing.T) {
+	_, progress := readWithProgress(test, &validate.BasicValidate{})
+
+	require.Empty(test, progress)
+}
+
+// ----------------------------------------------------------------------------
+// Helper functions
+// ----------------------------------------------------------------------------
+
+// Read testBadData with the validator and return what was written to stdout
+// and to stderr.
+func readWithProgress(t *testing.T, validator *validate.BasicValidate) (string, string) {
+	t.Helper()
+
+	reader, writer, cleanUp := mockStdout(t)
+	defer cleanUp()
+
+	errReader, errWriter, errCleanUp := mockStderr(t)
+	defer errCleanUp()
+
+	filename, moreCleanUp := createTempDataFile(t, testBadData, "jsonl")
+	defer moreCleanUp()
+
+	validator.InputURL = "file://" + filename
+	validator.Read(t.Context())
+
+	writer.Close()
+	errWriter.Close()
+
+	out, _ := io.ReadAll(reader)
+	errOut, _ := io.ReadAll(errReader)
+
+	return string(out), string(errOut)
+}
@@ -0,0 +1,105 @@
+package validate
+
+import (
+	"maps"
+	"slices"
+	"strings"
+)
+
+// RECORD_TYPE values allowed when RecordTypes is not set.
+var defaultRecordTypes = []string{"PERSON", "ORGANIZATION", "VESSEL", "AIRCRAFT"}
+
+// Attributes of people, not expected on records of other types.
+var personAttributes = []string{
+	"DATE_OF_BIRTH",
+	"DATE_OF_DEATH",
+	"GENDER",
+	"PASSPORT_NUMBER",
+	"SSN_LAST4",
+	"SSN_NUMBER",
+}
+
+// Attributes not expected on records of each RECORD_TYPE.  Records of other
+// types are not checked.
+var recordTypeInconsistentAttributes = map[string][]string{
+	"AIRCRAFT":     personAttributes,
+	"ORGANIZATION": personAttributes,
+	"PERSON":       {"NAME_ORG"},
+	"VESSEL":       personAttributes,
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Report a RECORD_TYPE that is not one of RecordTypes (or defaultRecordTypes),
+// and attributes, anywhere in the record, that are inconsistent with the
+// RECORD_TYPE, such as DATE_OF_BIRTH on an ORGANIZATION or NAME_ORG on a
+// PERSON.  Attributes with a usage type prefix, e.g. PRIMARY_NAME_ORG, are
+// inconsistent too.
+func (validate *BasicValidate) checkRecordType(object jsonObject) []issue {
+	recordType := strings.TrimSpace(object.getString("RECORD_TYPE"))
+	if recordType == "" {
+		return nil
+	}
+
+	recordTypes := validate.RecordTypes
+	if len(recordTypes) == 0 {
+		recordTypes = defaultRecordTypes
+	}
+
+	if !slices.ContainsFunc(recordTypes, func(allowed string) bool { return strings.EqualFold(allowed, recordType) }) {
+		return []issue{newWarning(3080, 3081, strings.Join(recordTypes, ", "), recordType)}
+	}
+
+	var issues []issue
+
+	inconsistentAttributes := recordTypeInconsistentAttributes[strings.ToUpper(recordType)]
+
+	walkJSON(object, "", func(path string, value any) {
+		if str, isString := value.(string); !isString || strings.TrimSpace(str) == "" {
+			return
+		}
+
+		attribute := attributeName(path)
+		isInconsistent := slices.ContainsFunc(inconsistentAttributes, func(inconsistent string) bool {
+			return attribute == inconsistent || strings.HasSuffix(attribute, "_"+inconsistent)
+		})
+
+		if isInconsistent {
+			issues = append(issues, newWarning(3082, 3083, path, strings.ToUpper(recordType)))
+		}
+	})
+
+	return issues
+}
+
+// ----------------------------------------------------------------------------
+
+// Log the number of records of each RECORD_TYPE, if any record has one.
+func (validate *BasicValidate) logRecordTypes(counts recordCounts) {
+	if len(counts.recordTypes) == 0 || len(counts.recordTypes) == 1 && counts.recordTypes[""] > 0 {
+		return
+	}
+
+	for _, recordType := range slices.Sorted(maps.Keys(counts.recordTypes)) {
+		if recordType == "" {
+			validate.log(2261, counts.recordTypes[recordType])
+		} else {
+			validate.log(2260, counts.recordTypes[recordType], recordType)
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Methods for recordCounts
+// ----------------------------------------------------------------------------
+
+// Count a record with the given RECORD_TYPE, "" if it has none.
+func (counts *recordCounts) addRecordType(recordType string) {
+	if counts.recordTypes == nil {
+		counts.recordTypes = map[string]int{}
+	}
+
+	counts.recordTypes[strings.ToUpper(strings.TrimSpace(recordType))]++
+}
@@ -0,0 +1,79 @@
+//go:build !windows
+
+package validate_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/senzing-garage/validate/validate"
+	"github.com/stretchr/testify/require"
+)
+
+const testRecordTypeData = `{"DATA_SOURCE": "TEST", "RECORD_ID": "1", "RECORD_TYPE": "ORGANIZATION", "NAME_ORG": "Acme", "DATE_OF_BIRTH": "1980-01-01", "GENDER": "M"}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "2", "RECORD_TYPE": "person", "NAMES": [{"PRIMARY_NAME_ORG": "Acme"}], "GENDER": "F"}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "3", "RECORD_TYPE": "SHIP"}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "4", "RECORD_TYPE": "VESSEL", "NAME_ORG": "Queen Mary"}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "5", "NAME_LAST": "Smith"}
+`
+
+// ----------------------------------------------------------------------------
+// test RECORD_TYPE validation
+// ----------------------------------------------------------------------------
+
+func TestBasicValidate_Read_record_types(test *testing.T) {
+	actual, result := readRecordTypes(test, nil)
+
+	require.Contains(test, actual, "Line 1: DATE_OF_BIRTH is not expected on a record with RECORD_TYPE ORGANIZATION")
+	require.Contains(test, actual, "Line 1: GENDER is not expected on a record with RECORD_TYPE ORGANIZATION")
+	require.Contains(test, actual, "Line 2: NAMES[0].PRIMARY_NAME_ORG is not expected on a record with RECORD_TYPE PERSON")
+	require.NotContains(test, actual, "Line 2: GENDER")
+	require.Contains(test, actual, `Line 3: RECORD_TYPE is not one of PERSON, ORGANIZATION, VESSEL, AIRCRAFT: "SHIP"`)
+	require.NotContains(test, actual, "Line 4:")
+	require.NotContains(test, actual, "Line 5:")
+	require.Contains(test, actual, "1 record(s) had a RECORD_TYPE that is not allowed")
+	require.Contains(test, actual, "2 record(s) had attributes inconsistent with their RECORD_TYPE")
+	require.Contains(test, actual, "1 record(s) had no RECORD_TYPE")
+	require.Contains(test, actual, "1 record(s) had RECORD_TYPE ORGANIZATION")
+	require.Contains(test, actual, "1 record(s) had RECORD_TYPE PERSON")
+	require.Contains(test, actual, "1 record(s) had RECORD_TYPE SHIP")
+	require.Contains(test, actual, "Validated 5 lines, 0 were bad")
+	require.True(test, result)
+}
+
+// RecordTypes replaces the built-in list of allowed RECORD_TYPE values.
+func TestBasicValidate_Read_record_types_custom(test *testing.T) {
+	actual, result := readRecordTypes(test, []string{"PERSON", "ORGANIZATION", "SHIP"})
+
+	require.NotContains(test, actual, "Line 3:")
+	require.Contains(test, actual, `Line 4: RECORD_TYPE is not one of PERSON, ORGANIZATION, SHIP: "VESSEL"`)
+	require.True(test, result)
+}
+
+// ----------------------------------------------------------------------------
+// Helper functions
+// ----------------------------------------------------------------------------
+
+// read testRecordTypeData with the given allowed record types and return the
+// output and result.
+func readRecordTypes(t *testing.T, recordTypes []string) (string, bool) {
+	t.Helper()
+
+	reader, writer, cleanUp := mockStdout(t)
+	defer cleanUp()
+
+	filename, moreCleanUp := createTempDataFile(t, testRecordTypeData, "jsonl")
+	defer moreCleanUp()
+
+	validator := &validate.BasicValidate{
+		InputURL:    "file://" + filename,
+		RecordTypes: recordTypes,
+	}
+	result := validator.Read(t.Context())
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+
+	return string(out), result
+}
@@ -0,0 +1,197 @@
+package validate
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// issue is a problem found in a record by a rule.  Each kind of problem has a
+// message, logged for every record having the problem, whose first detail is
+// the location of the record, and a summary message, logged with the number of
+// records having the problem once all of the input is read.  Records having an
+// error are counted as bad; records having only warnings are not.  Issues with
+// a summaryDetail are summarized separately for each summaryDetail, which is
+// logged after the number of records.
+type issue struct {
+	detailID      int
+	details       []any
+	isError       bool
+	summaryDetail string
+	summaryID     int
+}
+
+// issueSummary identifies a summary message and, for summaries logged for each
+// attribute or value, its detail.
+type issueSummary struct {
+	detail string
+	id     int
+}
+
+// attributeGroup holds the non-blank attributes of a feature, such as an
+// address, found in one object under one usage type prefix.  Attributes are
+// identified by the part of their name following the prefix and stem, e.g.
+// "CITY" for "HOME_ADDR_CITY".
+type attributeGroup struct {
+	attributes []string
+	label      string
+	prefix     string
+	stem       string
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Apply every rule to a parsed record, logging and counting the problems found.
+// str is the record as read.  Returns whether any problem is an error.
+func (validate *BasicValidate) validateAttributes(counts *recordCounts, location string, str string, object jsonObject) bool {
+	issues := slices.Concat(validate.checkRecordSize(str), validate.checkKeyCase(object))
+
+	if validate.LenientKeys {
+		canonicalizeAllKeys(object)
+	}
+
+	counts.addRecordType(object.getString("RECORD_TYPE"))
+
+	for _, rule := range validate.rules() {
+		issues = append(issues, rule(object)...)
+	}
+
+	return validate.reportIssues(counts, location, issues)
+}
+
+// ----------------------------------------------------------------------------
+
+// Log the issues found in a record and count them, once for each summary.
+// Returns whether any is an error.
+func (validate *BasicValidate) reportIssues(counts *recordCounts, location string, issues []issue) bool {
+	summaries := []issueSummary{}
+	isBad := false
+
+	for _, issue := range issues {
+		validate.log(issue.detailID, append([]any{location}, issue.details...)...)
+
+		summary := issueSummary{detail: issue.summaryDetail, id: issue.summaryID}
+		if !slices.Contains(summaries, summary) {
+			summaries = append(summaries, summary)
+		}
+
+		isBad = isBad || issue.isError
+	}
+
+	for _, summary := range summaries {
+		counts.addIssue(summary)
+	}
+
+	return isBad
+}
+
+// ----------------------------------------------------------------------------
+
+// The rules applied to each record, in the order their problems are reported.
+func (validate *BasicValidate) rules() []func(jsonObject) []issue {
+	return []func(jsonObject) []issue{
+		validate.checkSizes,
+		validate.checkTypes,
+		validate.checkEmptyValues,
+		validate.checkCharacters,
+		validate.checkRecordType,
+		validate.checkDates,
+		validate.checkAddresses,
+		validate.checkNames,
+		validate.checkIdentifiers,
+		validate.checkPhones,
+		validate.checkEmails,
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Methods for recordCounts
+// ----------------------------------------------------------------------------
+
+// Count a record having an issue reported with the given summary.
+func (counts *recordCounts) addIssue(summary issueSummary) {
+	if counts.issues == nil {
+		counts.issues = map[issueSummary]int{}
+	}
+
+	counts.issues[summary]++
+}
+
+// ----------------------------------------------------------------------------
+// Methods for attributeGroup
+// ----------------------------------------------------------------------------
+
+// Name the attributes, e.g. "HOME_ADDR_CITY, HOME_ADDR_STATE".
+func (group attributeGroup) list(attributes []string) string {
+	names := make([]string, len(attributes))
+	for index, attribute := range attributes {
+		names[index] = group.prefix + group.stem + attribute
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Group the non-blank attributes of an object matching pattern by usage type
+// prefix.  The pattern's first group must match the prefix, including its
+// trailing "_", and its second group the attribute following the stem.  Each
+// group is labeled by its path, e.g. "ADDRESSES[0].HOME_ADDR_*".
+func attributeGroups(path string, object jsonObject, pattern *regexp.Regexp, stem string) []attributeGroup {
+	var groups []attributeGroup
+
+	for _, member := range object {
+		match := pattern.FindStringSubmatch(member.Key)
+		if match == nil {
+			continue
+		}
+
+		if str, isString := member.Value.(string); isString && strings.TrimSpace(str) == "" {
+			continue
+		}
+
+		label := joinJSONPath(path, match[1]+stem+"*")
+
+		index := slices.IndexFunc(groups, func(group attributeGroup) bool { return group.label == label })
+		if index < 0 {
+			groups = append(groups, attributeGroup{attributes: nil, label: label, prefix: match[1], stem: stem})
+			index = len(groups) - 1
+		}
+
+		groups[index].attributes = append(groups[index].attributes, match[2])
+	}
+
+	return groups
+}
+
+// ----------------------------------------------------------------------------
+
+// An error makes the record bad.
+func newError(summaryID int, detailID int, details ...any) issue {
+	return issue{
+		detailID:  detailID,
+		details:   details,
+		isError:   true,
+		summaryID: summaryID,
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+// A warning is reported, but does not make the record bad.
+func newWarning(summaryID int, detailID int, details ...any) issue {
+	return issue{
+		detailID:  detailID,
+		details:   details,
+		isError:   false,
+		summaryID: summaryID,
+	}
+}
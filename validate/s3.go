@@ -0,0 +1,205 @@
+package validate
+
+import (
+	"compress/gzip"
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/senzing-garage/go-helpers/wraperror"
+)
+
+// Region used when neither the environment nor the shared AWS configuration specify one.
+const defaultS3Region = "us-east-1"
+
+// ----------------------------------------------------------------------------
+// Public methods
+// ----------------------------------------------------------------------------
+
+// opens and reads a JSONL or JSON object stored in S3.
+func (validate *BasicValidate) ReadJSONLS3Object(ctx context.Context, bucket string, key string) bool {
+	client, err := validate.getS3Client(ctx)
+	if err != nil {
+		validate.log(5013, err)
+
+		return false
+	}
+
+	object, err := client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		validate.log(5014, s3URL(bucket, key), err)
+
+		return false
+	}
+
+	defer object.Body.Close()
+
+	return validate.validateStream(ctx, validate.countBytes(object.Body, aws.ToInt64(object.ContentLength)), key)
+}
+
+// ----------------------------------------------------------------------------
+
+// opens and reads a JSONL or JSON object stored in S3 that has been GZIPped.
+func (validate *BasicValidate) ReadGZIPS3Object(ctx context.Context, bucket string, key string) bool {
+	client, err := validate.getS3Client(ctx)
+	if err != nil {
+		validate.log(5013, err)
+
+		return false
+	}
+
+	object, err := client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		validate.log(5014, s3URL(bucket, key), err)
+
+		return false
+	}
+
+	defer object.Body.Close()
+
+	reader, err := gzip.NewReader(validate.countBytes(object.Body, aws.ToInt64(object.ContentLength)))
+	if err != nil {
+		validate.log(5015, s3URL(bucket, key), err)
+
+		return false
+	}
+
+	defer reader.Close()
+
+	return validate.validateStream(ctx, reader, key)
+}
+
+// ----------------------------------------------------------------------------
+
+// lists the objects in an S3 bucket having the given prefix and reads each
+// JSONL, JSON or GZIPped object found.
+func (validate *BasicValidate) ReadS3Prefix(ctx context.Context, bucket string, prefix string) bool {
+	client, err := validate.getS3Client(ctx)
+	if err != nil {
+		validate.log(5013, err)
+
+		return false
+	}
+
+	var keys []string
+
+	paginator := s3.NewListObjectsV2Paginator(client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(prefix),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			validate.log(5016, s3URL(bucket, prefix), err)
+
+			return false
+		}
+
+		for _, object := range page.Contents {
+			key := aws.ToString(object.Key)
+			if validate.isJSONL(key) || validate.isJSON(key) || validate.isGZIP(key) {
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	if len(keys) == 0 {
+		validate.log(5017, s3URL(bucket, prefix))
+
+		return false
+	}
+
+	result := true
+
+	for _, key := range keys {
+		if ctx.Err() != nil {
+			return false
+		}
+
+		validate.log(2209, s3URL(bucket, key))
+
+		if validate.isGZIP(key) {
+			result = validate.ReadGZIPS3Object(ctx, bucket, key) && result
+		} else {
+			result = validate.ReadJSONLS3Object(ctx, bucket, key) && result
+		}
+	}
+
+	return result
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Create an S3 client from the standard AWS environment variables and shared
+// configuration files.  If S3Endpoint is set, it overrides the AWS endpoint and
+// path-style addressing is used, as expected by MinIO and similar stores.
+func (validate *BasicValidate) getS3Client(ctx context.Context) (*s3.Client, error) {
+	awsConfig, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, wraperror.Errorf(err, "config.LoadDefaultConfig")
+	}
+
+	if awsConfig.Region == "" {
+		awsConfig.Region = defaultS3Region
+	}
+
+	client := s3.NewFromConfig(awsConfig, func(options *s3.Options) {
+		options.DisableLogOutputChecksumValidationSkipped = true
+
+		if validate.S3Endpoint != "" {
+			options.BaseEndpoint = aws.String(validate.S3Endpoint)
+			options.UsePathStyle = true
+		}
+	})
+
+	return client, nil
+}
+
+// ----------------------------------------------------------------------------
+
+func (validate *BasicValidate) validateBasedOnS3URL(ctx context.Context, parsedURL *url.URL) bool {
+	bucket := parsedURL.Host
+	key := strings.TrimPrefix(parsedURL.Path, "/")
+
+	switch {
+	case key == "", strings.HasSuffix(key, "/"):
+		validate.log(2208, s3URL(bucket, key))
+
+		return validate.ReadS3Prefix(ctx, bucket, key)
+	case validate.isJSONL(key):
+		validate.log(2206)
+
+		return validate.ReadJSONLS3Object(ctx, bucket, key)
+	case validate.isGZIP(key):
+		validate.log(2207)
+
+		return validate.ReadGZIPS3Object(ctx, bucket, key)
+	case validate.isJSON(key):
+		validate.log(2214)
+
+		return validate.ReadJSONLS3Object(ctx, bucket, key)
+	default:
+		validate.log(5018)
+	}
+
+	return false
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+func s3URL(bucket string, key string) string {
+	return "s3://" + bucket + "/" + key
+}
@@ -0,0 +1,277 @@
+//go:build !windows
+
+package validate_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/senzing-garage/validate/validate"
+	"github.com/stretchr/testify/require"
+)
+
+const testBucket = "test-bucket"
+
+// ----------------------------------------------------------------------------
+// test Read S3 objects
+// ----------------------------------------------------------------------------
+
+func TestBasicValidate_Read_s3_jsonl(test *testing.T) {
+	ctx := test.Context()
+	server := serveS3(test, map[string][]byte{"data/good.jsonl": []byte(testGoodData)})
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	validator := &validate.BasicValidate{
+		InputURL:   "s3://" + testBucket + "/data/good.jsonl",
+		S3Endpoint: server.URL,
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "Validating as a JSONL S3 object")
+	require.Contains(test, actual, expected12good)
+	require.True(test, result)
+}
+
+func TestBasicValidate_Read_s3_gzip(test *testing.T) {
+	ctx := test.Context()
+	server := serveS3(test, map[string][]byte{"data/bad.jsonl.gz": gzipBytes(test, testBadData)})
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	validator := &validate.BasicValidate{
+		InputURL:   "s3://" + testBucket + "/data/bad.jsonl.gz",
+		S3Endpoint: server.URL,
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "Validating a GZIP S3 object")
+	require.Contains(test, actual, expected16good4bad)
+	require.True(test, result)
+}
+
+func TestBasicValidate_Read_s3_gzip_not_gzipped(test *testing.T) {
+	ctx := test.Context()
+	server := serveS3(test, map[string][]byte{"data/bad.jsonl.gz": []byte(testBadData)})
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	validator := &validate.BasicValidate{
+		InputURL:   "s3://" + testBucket + "/data/bad.jsonl.gz",
+		S3Endpoint: server.URL,
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "Fatal error reading GZIPped S3 object")
+	require.False(test, result)
+}
+
+func TestBasicValidate_Read_s3_prefix(test *testing.T) {
+	ctx := test.Context()
+	server := serveS3(test, map[string][]byte{
+		"data/a.jsonl":    []byte(testGoodData),
+		"data/b.jsonl.gz": gzipBytes(test, testBadData),
+		"data/readme.txt": []byte("not a data file"),
+		"other/c.jsonl":   []byte(testGoodData),
+	})
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	validator := &validate.BasicValidate{
+		InputURL:   "s3://" + testBucket + "/data/",
+		S3Endpoint: server.URL,
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "Validating S3 objects with prefix: s3://test-bucket/data/")
+	require.Contains(test, actual, "Validating S3 object: s3://test-bucket/data/a.jsonl")
+	require.Contains(test, actual, "Validating S3 object: s3://test-bucket/data/b.jsonl.gz")
+	require.NotContains(test, actual, "readme.txt")
+	require.NotContains(test, actual, "other/c.jsonl")
+	require.Contains(test, actual, expected12good)
+	require.Contains(test, actual, expected16good4bad)
+	require.True(test, result)
+}
+
+func TestBasicValidate_Read_s3_prefix_empty(test *testing.T) {
+	ctx := test.Context()
+	server := serveS3(test, map[string][]byte{"data/readme.txt": []byte("not a data file")})
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	validator := &validate.BasicValidate{
+		InputURL:   "s3://" + testBucket + "/data/",
+		S3Endpoint: server.URL,
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "no JSONL, JSON or GZIP S3 objects found with prefix: s3://test-bucket/data/")
+	require.False(test, result)
+}
+
+func TestBasicValidate_Read_s3_object_does_not_exist(test *testing.T) {
+	ctx := test.Context()
+	server := serveS3(test, map[string][]byte{})
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	validator := &validate.BasicValidate{
+		InputURL:   "s3://" + testBucket + "/data/missing.jsonl",
+		S3Endpoint: server.URL,
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "Fatal error retrieving S3 object: s3://test-bucket/data/missing.jsonl")
+	require.False(test, result)
+}
+
+func TestBasicValidate_Read_s3_unknown_extension(test *testing.T) {
+	ctx := test.Context()
+	server := serveS3(test, map[string][]byte{"data/good.txt": []byte(testGoodData)})
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	validator := &validate.BasicValidate{
+		InputURL:   "s3://" + testBucket + "/data/good.txt",
+		S3Endpoint: server.URL,
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "If this is a valid JSONL S3 object")
+	require.False(test, result)
+}
+
+// ----------------------------------------------------------------------------
+// Helper functions
+// ----------------------------------------------------------------------------
+
+// gzip the given content.
+func gzipBytes(t *testing.T, content string) []byte {
+	t.Helper()
+
+	var buffer bytes.Buffer
+
+	writer := gzip.NewWriter(&buffer)
+	_, err := writer.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	return buffer.Bytes()
+}
+
+// serve a minimal, path-style, S3-compatible API holding the given objects in
+// testBucket.  Only GetObject and ListObjectsV2 are supported.
+func serveS3(t *testing.T, objects map[string][]byte) *httptest.Server {
+	t.Helper()
+
+	t.Setenv("AWS_ACCESS_KEY_ID", "test-access-key")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "test-secret-key")
+	t.Setenv("AWS_REGION", "us-east-1")
+
+	type listContents struct {
+		Key  string `xml:"Key"`
+		Size int    `xml:"Size"`
+	}
+
+	type listBucketResult struct {
+		XMLName     xml.Name       `xml:"ListBucketResult"`
+		Name        string         `xml:"Name"`
+		Prefix      string         `xml:"Prefix"`
+		KeyCount    int            `xml:"KeyCount"`
+		IsTruncated bool           `xml:"IsTruncated"`
+		Contents    []listContents `xml:"Contents"`
+	}
+
+	handler := http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		path := strings.TrimPrefix(request.URL.Path, "/")
+		bucket, key, _ := strings.Cut(path, "/")
+
+		if bucket != testBucket {
+			http.Error(writer, "NoSuchBucket", http.StatusNotFound)
+
+			return
+		}
+
+		if key == "" && request.URL.Query().Get("list-type") == "2" {
+			prefix := request.URL.Query().Get("prefix")
+			result := listBucketResult{Name: bucket, Prefix: prefix}
+
+			for objectKey, content := range objects {
+				if strings.HasPrefix(objectKey, prefix) {
+					result.Contents = append(result.Contents, listContents{Key: objectKey, Size: len(content)})
+				}
+			}
+
+			sort.Slice(result.Contents, func(i, j int) bool { return result.Contents[i].Key < result.Contents[j].Key })
+			result.KeyCount = len(result.Contents)
+
+			writer.Header().Set("Content-Type", "application/xml")
+			_ = xml.NewEncoder(writer).Encode(result)
+
+			return
+		}
+
+		content, isOK := objects[key]
+		if !isOK {
+			http.Error(writer, "NoSuchKey", http.StatusNotFound)
+
+			return
+		}
+
+		_, _ = writer.Write(content)
+	})
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return server
+}
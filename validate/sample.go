@@ -0,0 +1,168 @@
+package validate
+
+import (
+	"math"
+	"math/rand/v2"
+)
+
+// The z-score of a 95% confidence interval.
+const confidenceZ = 1.96
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// recordSample selects the records validated, whether lines of JSONL, elements
+// of a JSON array or Parquet rows: those after the first Skip records, up to
+// Head records, each chosen with probability SampleRate.  The records are
+// called unit in messages, and summaryID logs how many were validated when
+// not sampling.
+type recordSample struct {
+	head      int
+	random    *rand.Rand
+	rate      float64
+	read      int
+	sampled   int
+	skip      int
+	summaryID int
+	unit      string
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Check that SampleRate is a fraction and Head and Skip are not negative.
+func (validate *BasicValidate) isSampleSupported() bool {
+	if validate.SampleRate < 0 || validate.SampleRate > 1 {
+		validate.log(5036, validate.SampleRate)
+
+		return false
+	}
+
+	if validate.Head < 0 || validate.Skip < 0 {
+		validate.log(5037, validate.Head, validate.Skip)
+
+		return false
+	}
+
+	return true
+}
+
+// ----------------------------------------------------------------------------
+
+// Log the sample of the records, called unit, to be validated, if any, and
+// return its selector.
+func (validate *BasicValidate) newRecordSample(unit string, summaryID int) *recordSample {
+	sample := &recordSample{
+		head:      validate.Head,
+		random:    nil,
+		rate:      validate.SampleRate,
+		read:      0,
+		sampled:   0,
+		skip:      validate.Skip,
+		summaryID: summaryID,
+		unit:      unit,
+	}
+
+	if sample.skip > 0 {
+		validate.log(2283, sample.skip, unit)
+	}
+
+	if sample.head > 0 {
+		validate.log(2284, sample.head, unit)
+	}
+
+	if sample.isSampling() {
+		seed := validate.SampleSeed
+		if seed == 0 {
+			seed = rand.Uint64() //nolint:gosec
+		}
+
+		validate.log(2280, sample.rate, sample.unit, seed)
+
+		sample.random = rand.New(rand.NewPCG(seed, seed)) //nolint:gosec
+	}
+
+	return sample
+}
+
+// ----------------------------------------------------------------------------
+
+// Log how many records were validated and bad, and, when sampling, the number
+// and percentage of the records read estimated to be bad.
+func (validate *BasicValidate) logSample(sample *recordSample, bad int) {
+	if !sample.isSampling() {
+		validate.log(sample.summaryID, sample.read, bad)
+
+		return
+	}
+
+	validate.log(2281, sample.sampled, sample.read, sample.unit, bad)
+
+	if sample.sampled == 0 {
+		return
+	}
+
+	proportion := float64(bad) / float64(sample.sampled)
+	low, high := wilsonInterval(bad, sample.sampled)
+
+	validate.log(2282, int(math.Round(proportion*float64(sample.read))), sample.read, sample.unit, 100*proportion,
+		100*low, 100*high)
+}
+
+// ----------------------------------------------------------------------------
+// Methods for recordSample
+// ----------------------------------------------------------------------------
+
+// Whether the record, numbered from 1, is past the Head records after those
+// skipped, so reading can stop.
+func (sample *recordSample) isDone(record int) bool {
+	return sample.head > 0 && record > sample.skip+sample.head
+}
+
+// ----------------------------------------------------------------------------
+
+// Whether the record, numbered from 1, is to be validated, counting it as read
+// if it is not skipped.
+func (sample *recordSample) isSelected(record int) bool {
+	if record <= sample.skip {
+		return false
+	}
+
+	sample.read++
+
+	if sample.random != nil && sample.random.Float64() >= sample.rate {
+		return false
+	}
+
+	sample.sampled++
+
+	return true
+}
+
+// ----------------------------------------------------------------------------
+
+// Whether only a random fraction of the records is validated.
+func (sample *recordSample) isSampling() bool {
+	return sample.rate > 0 && sample.rate < 1
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// The Wilson score 95% confidence interval of the proportion of bad records, which
+// unlike the normal approximation stays within 0 to 1 for small samples and
+// proportions near 0.
+func wilsonInterval(bad int, sampled int) (float64, float64) {
+	total := float64(sampled)
+	proportion := float64(bad) / total
+	zSquared := confidenceZ * confidenceZ
+
+	center := (proportion + zSquared/(2*total)) / (1 + zSquared/total)
+	margin := confidenceZ / (1 + zSquared/total) *
+		math.Sqrt(proportion*(1-proportion)/total+zSquared/(4*total*total))
+
+	return max(0, center-margin), min(1, center+margin)
+}
@@ -0,0 +1,101 @@
+//go:build !windows
+
+package validate_test
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/senzing-garage/validate/validate"
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------------------------------------------------------------
+// test sampling
+// ----------------------------------------------------------------------------
+
+func TestBasicValidate_Read_head_and_skip(test *testing.T) {
+	actual, result := sampleRecords(test, &validate.BasicValidate{Head: 3, Skip: 2})
+
+	require.Contains(test, actual, "Skipping the first 2 lines")
+	require.Contains(test, actual, "Reading at most 3 lines")
+	require.NotContains(test, actual, "Line 2:")
+	require.Contains(test, actual, "Line 3: a RECORD_ID field is required")
+	require.NotContains(test, actual, "Line 6:")
+	require.Contains(test, actual, "Validated 3 lines, 1 were bad")
+	require.True(test, result)
+}
+
+// ----------------------------------------------------------------------------
+
+// The same seed validates the same sample, and the bad lines are extrapolated
+// to the lines read.
+func TestBasicValidate_Read_sample_rate(test *testing.T) {
+	actual, result := sampleRecords(test, &validate.BasicValidate{SampleRate: 0.5, SampleSeed: 42})
+
+	require.Contains(test, actual, "Sampling a fraction 0.5 of lines with seed 42")
+	require.Contains(test, actual, "of 100 lines read")
+	require.Contains(test, actual, "lines read are bad")
+	require.Contains(test, actual, "with a 95% confidence interval of")
+	require.True(test, result)
+
+	again, _ := sampleRecords(test, &validate.BasicValidate{SampleRate: 0.5, SampleSeed: 42})
+	require.Equal(test, actual, again)
+}
+
+// ----------------------------------------------------------------------------
+
+// A sample rate of 1 validates every line.
+func TestBasicValidate_Read_sample_rate_all(test *testing.T) {
+	actual, result := sampleRecords(test, &validate.BasicValidate{SampleRate: 1})
+
+	require.NotContains(test, actual, "Sampling")
+	require.Contains(test, actual, "Validated 100 lines, 10 were bad")
+	require.True(test, result)
+}
+
+// ----------------------------------------------------------------------------
+
+func TestBasicValidate_Read_sample_rate_not_a_fraction(test *testing.T) {
+	actual, result := sampleRecords(test, &validate.BasicValidate{SampleRate: 1.5})
+
+	require.Contains(test, actual, "Fatal error sample-rate must be a fraction from 0 to 1: 1.5")
+	require.False(test, result)
+}
+
+// ----------------------------------------------------------------------------
+// Helper functions
+// ----------------------------------------------------------------------------
+
+// Read 100 records, every tenth of which, starting with line 3, has no
+// RECORD_ID, with the validator and return the output and result.
+func sampleRecords(t *testing.T, validator *validate.BasicValidate) (string, bool) {
+	t.Helper()
+
+	reader, writer, cleanUp := mockStdout(t)
+	defer cleanUp()
+
+	var data strings.Builder
+
+	for line := 1; line <= 100; line++ {
+		if line%10 == 3 {
+			fmt.Fprintf(&data, "{\"DATA_SOURCE\": \"TEST\", \"NAME_FULL\": \"Name %d\"}\n", line)
+		} else {
+			fmt.Fprintf(&data, "{\"DATA_SOURCE\": \"TEST\", \"RECORD_ID\": \"%d\"}\n", line)
+		}
+	}
+
+	filename, moreCleanUp := createTempDataFile(t, data.String(), "jsonl")
+	defer moreCleanUp()
+
+	validator.InputURL = "file://" + filename
+	result := validator.Read(t.Context())
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+
+	return strings.ReplaceAll(string(out), filename, "input"), result
+}
@@ -0,0 +1,108 @@
+package validate
+
+import "errors"
+
+// ----------------------------------------------------------------------------
+// Types
+// ----------------------------------------------------------------------------
+
+// recordError is why a record is not a valid Generic Entity Specification
+// record.  It wraps both the reason, one of the errRecord sentinels, and the
+// error that revealed it, if any, such as a JSON syntax error, so the reason is
+// found with errors.Is whatever the wording of the error.
+type recordError struct {
+	err    error
+	reason error
+}
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Log and count each reason a record failed checkRecord.
+func (validate *BasicValidate) reportRecordErrors(counts *recordCounts, location string, err error) {
+	for _, reason := range []struct {
+		count     *int
+		err       error
+		messageID int
+	}{
+		{count: &counts.malformed, err: errRecordMalformed, messageID: 3007},
+		{count: &counts.notObject, err: errRecordNotObject, messageID: 3013},
+		{count: &counts.trailingData, err: errRecordTrailingData, messageID: 3015},
+		{count: &counts.noDataSource, err: errRecordNoDataSource, messageID: 3006},
+		{count: &counts.emptyDataSource, err: errRecordEmptyDataSource, messageID: 3017},
+		{count: &counts.noRecordID, err: errRecordNoRecordID, messageID: 3005},
+		{count: &counts.emptyRecordID, err: errRecordEmptyRecordID, messageID: 3019},
+	} {
+		if errors.Is(err, reason.err) {
+			validate.log(reason.messageID, location)
+
+			*reason.count++
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Methods for recordError
+// ----------------------------------------------------------------------------
+
+func (recordErr *recordError) Error() string {
+	if recordErr.err == nil {
+		return recordErr.reason.Error()
+	}
+
+	return recordErr.reason.Error() + ": " + recordErr.err.Error()
+}
+
+// ----------------------------------------------------------------------------
+
+func (recordErr *recordError) Unwrap() []error {
+	return []error{recordErr.reason, recordErr.err}
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Check that a record is a JSON object with non-empty DATA_SOURCE and RECORD_ID
+// values, and parse it.  Each problem found is returned as a *recordError,
+// joined by errors.Join.  Keys differing only in case or white space, and
+// values that are not strings, are left to checkKeyCase and checkTypes.
+// Returns the parsed record, or nil if it is not a JSON object.
+func checkRecord(str string) (jsonObject, error) {
+	object, err := parseJSONObject(str)
+	if err != nil {
+		reason := errRecordMalformed
+
+		switch {
+		case errors.Is(err, errRecordNotObject):
+			reason = errRecordNotObject
+		case errors.Is(err, errRecordTrailingData):
+			reason = errRecordTrailingData
+		}
+
+		return nil, &recordError{err: err, reason: reason}
+	}
+
+	var errs []error
+
+	for _, attribute := range []struct {
+		key        string
+		emptyError error
+		noError    error
+	}{
+		{key: "DATA_SOURCE", emptyError: errRecordEmptyDataSource, noError: errRecordNoDataSource},
+		{key: "RECORD_ID", emptyError: errRecordEmptyRecordID, noError: errRecordNoRecordID},
+	} {
+		value, isFound := lookupKey(object, attribute.key)
+
+		switch {
+		case !isFound:
+			errs = append(errs, &recordError{err: nil, reason: attribute.noError})
+		case value == nil || value == "":
+			errs = append(errs, &recordError{err: nil, reason: attribute.emptyError})
+		}
+	}
+
+	return object, errors.Join(errs...)
+}
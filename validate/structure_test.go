@@ -0,0 +1,106 @@
+//go:build !windows
+
+package validate_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/senzing-garage/validate/validate"
+	"github.com/stretchr/testify/require"
+)
+
+// A line failing each structural check, then a valid record.
+const testStructureData = `{"DATA_SOURCE": "TEST", "RECORD_ID": "1"
+["DATA_SOURCE", "TEST", "RECORD_ID", "2"]
+{"DATA_SOURCE": "TEST", "RECORD_ID": "3"} {"DATA_SOURCE": "TEST", "RECORD_ID": "4"}
+{"RECORD_ID": "5"}
+{"DATA_SOURCE": "", "RECORD_ID": "6"}
+{"DATA_SOURCE": "TEST"}
+{"DATA_SOURCE": "TEST", "RECORD_ID": null}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "8"}
+`
+
+// ----------------------------------------------------------------------------
+// test structural checks
+// ----------------------------------------------------------------------------
+
+func TestBasicValidate_Read_structure(test *testing.T) {
+	ctx := test.Context()
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	filename, moreCleanUp := createTempDataFile(test, testStructureData, "jsonl")
+	defer moreCleanUp()
+
+	validator := &validate.BasicValidate{
+		InputURL: "file://" + filename,
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "Line 1: record is not well formed JSON")
+	require.Contains(test, actual, "Line 2: record is not a JSON object")
+	require.Contains(test, actual, "Line 3: record has data after the JSON object")
+	require.Contains(test, actual, "Line 4: a DATA_SOURCE field is required")
+	require.Contains(test, actual, "Line 5: the DATA_SOURCE field is empty")
+	require.Contains(test, actual, "Line 6: a RECORD_ID field is required")
+	require.Contains(test, actual, "Line 7: the RECORD_ID field is empty")
+	require.NotContains(test, actual, "Line 8:")
+	require.NotContains(test, actual, "unknown reason")
+	require.Contains(test, actual, "1 record(s) were not well formed JSON")
+	require.Contains(test, actual, "1 record(s) were JSON values other than objects")
+	require.Contains(test, actual, "1 record(s) had data after the JSON object")
+	require.Contains(test, actual, "1 record(s) had no DATA_SOURCE field")
+	require.Contains(test, actual, "1 record(s) had an empty DATA_SOURCE field")
+	require.Contains(test, actual, "1 record(s) had no RECORD_ID field")
+	require.Contains(test, actual, "1 record(s) had an empty RECORD_ID field")
+	require.Contains(test, actual, "Validated 8 lines, 7 were bad")
+	require.True(test, result)
+}
+
+// ----------------------------------------------------------------------------
+
+// Every problem on a line is reported and counted, but the line is counted as
+// bad once.
+func TestBasicValidate_Read_structure_all_problems(test *testing.T) {
+	ctx := test.Context()
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	data := `{"NAME_FULL": "Bob Smith", "DATE_OF_BIRTH": "1980-02-31"}
+{"DATA_SOURCE": "", "RECORD_ID": 2}
+`
+
+	filename, moreCleanUp := createTempDataFile(test, data, "jsonl")
+	defer moreCleanUp()
+
+	validator := &validate.BasicValidate{
+		InputURL: "file://" + filename,
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "Line 1: a DATA_SOURCE field is required")
+	require.Contains(test, actual, "Line 1: a RECORD_ID field is required")
+	require.Contains(test, actual, `Line 1: DATE_OF_BIRTH is an impossible date: "1980-02-31"`)
+	require.Contains(test, actual, "Line 2: the DATA_SOURCE field is empty")
+	require.Contains(test, actual, "Line 2: RECORD_ID is a number, not a string: 2")
+	require.Contains(test, actual, "1 record(s) had no DATA_SOURCE field")
+	require.Contains(test, actual, "1 record(s) had no RECORD_ID field")
+	require.Contains(test, actual, "1 record(s) had an empty DATA_SOURCE field")
+	require.Contains(test, actual, "1 record(s) had impossible dates")
+	require.Contains(test, actual, "1 record(s) had numbers or booleans where a string is expected")
+	require.Contains(test, actual, "Validated 2 lines, 2 were bad")
+	require.True(test, result)
+}
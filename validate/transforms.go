@@ -0,0 +1,165 @@
+package validate
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Check that every one of RenameAttributes is in KEY=NEWKEY form.
+func (validate *BasicValidate) areRenamesSupported() bool {
+	for _, rename := range validate.RenameAttributes {
+		key, newKey, isFound := strings.Cut(rename, "=")
+		if !isFound || strings.TrimSpace(key) == "" || strings.TrimSpace(newKey) == "" {
+			validate.log(5035, rename)
+
+			return false
+		}
+	}
+
+	return true
+}
+
+// ----------------------------------------------------------------------------
+
+// The attributes to rename, keyed by the canonical form of their current name.
+func (validate *BasicValidate) renames() map[string]string {
+	if validate.renamesByAttribute == nil {
+		validate.renamesByAttribute = map[string]string{}
+		for _, rename := range validate.RenameAttributes {
+			key, newKey, _ := strings.Cut(rename, "=")
+			validate.renamesByAttribute[canonicalKey(key)] = strings.TrimSpace(newKey)
+		}
+	}
+
+	return validate.renamesByAttribute
+}
+
+// ----------------------------------------------------------------------------
+
+// Apply RenameAttributes, DropAttributes and DefaultDataSource, in that order,
+// to a record before it is validated, logging each change.  Returns the record
+// unchanged if nothing was transformed or it is not a JSON object.
+func (validate *BasicValidate) transformRecord(location string, str string) string {
+	if len(validate.RenameAttributes) == 0 && len(validate.DropAttributes) == 0 && validate.DefaultDataSource == "" {
+		return str
+	}
+
+	object, err := parseJSONObject(str)
+	if err != nil {
+		return str
+	}
+
+	fix := &recordFix{changes: 0, location: location, validate: validate}
+
+	if len(validate.RenameAttributes) > 0 {
+		fix.renameKeys(object, "")
+	}
+
+	if len(validate.DropAttributes) > 0 {
+		object, _ = fix.dropKeys(object, "").(jsonObject)
+	}
+
+	if validate.DefaultDataSource != "" {
+		object = fix.setDataSource(object)
+	}
+
+	if fix.changes == 0 {
+		return str
+	}
+
+	transformed, err := marshalJSON(object)
+	if err != nil {
+		return str
+	}
+
+	return string(transformed)
+}
+
+// ----------------------------------------------------------------------------
+// Methods for recordFix
+// ----------------------------------------------------------------------------
+
+// Remove the members of DropAttributes from every object in the value.  Keys
+// are compared in their canonical form, as the key-case repair may already
+// have upper cased them.
+func (fix *recordFix) dropKeys(value any, path string) any {
+	switch typedValue := value.(type) {
+	case jsonObject:
+		kept := typedValue[:0]
+		for _, member := range typedValue {
+			memberPath := joinJSONPath(path, member.Key)
+			if slices.ContainsFunc(fix.validate.DropAttributes, func(attribute string) bool {
+				return canonicalKey(attribute) == canonicalKey(member.Key)
+			}) {
+				fix.change(2277, memberPath)
+
+				continue
+			}
+
+			member.Value = fix.dropKeys(member.Value, memberPath)
+			kept = append(kept, member)
+		}
+
+		return kept
+	case []any:
+		for index, element := range typedValue {
+			typedValue[index] = fix.dropKeys(element, fmt.Sprintf("%s[%d]", path, index))
+		}
+	}
+
+	return value
+}
+
+// ----------------------------------------------------------------------------
+
+// Rename the keys of RenameAttributes in every object in the value, unless the
+// object already has the new key.  Keys are compared in their canonical form.
+func (fix *recordFix) renameKeys(value any, path string) {
+	switch typedValue := value.(type) {
+	case jsonObject:
+		for index, member := range typedValue {
+			memberPath := joinJSONPath(path, member.Key)
+			if newKey, isFound := fix.validate.renames()[canonicalKey(member.Key)]; isFound {
+				if _, isSet := lookupKey(typedValue, canonicalKey(newKey)); isSet {
+					fix.validate.log(2278, fix.location, memberPath, newKey)
+				} else {
+					typedValue[index].Key = newKey
+					fix.change(2272, memberPath, newKey)
+				}
+			}
+
+			fix.renameKeys(member.Value, joinJSONPath(path, typedValue[index].Key))
+		}
+	case []any:
+		for index, element := range typedValue {
+			fix.renameKeys(element, fmt.Sprintf("%s[%d]", path, index))
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+// Set DATA_SOURCE to DefaultDataSource if it is missing or blank, whatever the
+// case of its key.
+func (fix *recordFix) setDataSource(object jsonObject) jsonObject {
+	value, isFound := lookupKey(object, "DATA_SOURCE")
+	if str, isString := value.(string); isFound && (!isString || strings.TrimSpace(str) != "") {
+		return object
+	}
+
+	fix.change(2274, fix.validate.DefaultDataSource)
+
+	index := slices.IndexFunc(object, func(member jsonMember) bool { return canonicalKey(member.Key) == "DATA_SOURCE" })
+	if index >= 0 {
+		object[index].Value = fix.validate.DefaultDataSource
+
+		return object
+	}
+
+	return slices.Insert(object, 0, jsonMember{Key: "DATA_SOURCE", Value: fix.validate.DefaultDataSource})
+}
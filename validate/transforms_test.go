@@ -0,0 +1,142 @@
+//go:build !windows
+
+package validate_test
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/senzing-garage/validate/validate"
+	"github.com/stretchr/testify/require"
+)
+
+// Records using legacy attribute names, an attribute to drop and no
+// DATA_SOURCE.
+const testTransformData = `{"SOURCE_ID": "1", "NAME_FULL": "Bob Smith", "INTERNAL_NOTES": "call back"}
+{"SOURCE_ID": "2", "RECORD_ID": "2", "NAMES": [{"NAME_FULL": "Mary Jones", "INTERNAL_NOTES": "none"}]}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "3", "NAME_FULL": "Sam Brown"}
+`
+
+// ----------------------------------------------------------------------------
+// test transforms
+// ----------------------------------------------------------------------------
+
+func TestBasicValidate_Read_transforms(test *testing.T) {
+	actual, result := transformRecords(test, &validate.BasicValidate{
+		DefaultDataSource: "CUSTOMERS",
+		DropAttributes:    []string{"INTERNAL_NOTES"},
+		RenameAttributes:  []string{"SOURCE_ID=RECORD_ID"},
+	}, testTransformData, false)
+
+	require.Contains(test, actual, "Line 1: Renamed SOURCE_ID to RECORD_ID")
+	require.Contains(test, actual, "Line 1: Dropped INTERNAL_NOTES")
+	require.Contains(test, actual, `Line 1: Set DATA_SOURCE to "CUSTOMERS"`)
+	require.Contains(test, actual, "Line 2: Did not rename SOURCE_ID to RECORD_ID, which is already set")
+	require.Contains(test, actual, "Line 2: Dropped NAMES[0].INTERNAL_NOTES")
+	require.NotContains(test, actual, "Line 3:")
+	require.Contains(test, actual, "Validated 3 lines, 0 were bad")
+	require.True(test, result)
+}
+
+// ----------------------------------------------------------------------------
+
+// Without the transforms, the records have no DATA_SOURCE or RECORD_ID.
+func TestBasicValidate_Read_no_transforms(test *testing.T) {
+	actual, result := transformRecords(test, &validate.BasicValidate{}, testTransformData, false)
+
+	require.NotContains(test, actual, "Renamed")
+	require.Contains(test, actual, "Line 1: a DATA_SOURCE field is required")
+	require.Contains(test, actual, "Validated 3 lines, 2 were bad")
+	require.True(test, result)
+}
+
+// ----------------------------------------------------------------------------
+
+// Fix writes the transformed records.
+func TestBasicValidate_Fix_transforms(test *testing.T) {
+	outputFile := filepath.Join(test.TempDir(), "fixed.jsonl")
+	_, result := transformRecords(test, &validate.BasicValidate{
+		DefaultDataSource: "CUSTOMERS",
+		DropAttributes:    []string{"INTERNAL_NOTES"},
+		FixOutputFile:     outputFile,
+		RenameAttributes:  []string{"SOURCE_ID=RECORD_ID"},
+	}, testTransformData, true)
+
+	require.True(test, result)
+
+	fixed, err := os.ReadFile(outputFile)
+	require.NoError(test, err)
+
+	expected := `{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1","NAME_FULL":"Bob Smith"}
+{"DATA_SOURCE":"CUSTOMERS","SOURCE_ID":"2","RECORD_ID":"2","NAMES":[{"NAME_FULL":"Mary Jones"}]}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "3", "NAME_FULL": "Sam Brown"}
+`
+	require.Equal(test, expected, string(fixed))
+}
+
+// ----------------------------------------------------------------------------
+
+// The transforms match keys whatever their case, before or after the key-case
+// repair upper cases them.
+func TestBasicValidate_Fix_transforms_lower_case_keys(test *testing.T) {
+	outputFile := filepath.Join(test.TempDir(), "fixed.jsonl")
+	actual, result := transformRecords(test, &validate.BasicValidate{
+		DefaultDataSource: "CUSTOMERS",
+		DropAttributes:    []string{"internal_notes"},
+		FixOutputFile:     outputFile,
+		RenameAttributes:  []string{"source_id=RECORD_ID"},
+	}, `{"data_source": "", "SOURCE_ID": "1", "internal_notes": "call back", "name_full": "Bob Smith"}`+"\n", true)
+
+	require.Contains(test, actual, "Line 1: Renamed SOURCE_ID to RECORD_ID")
+	require.Contains(test, actual, "Line 1: Dropped INTERNAL_NOTES")
+	require.Contains(test, actual, `Line 1: Set DATA_SOURCE to "CUSTOMERS"`)
+	require.True(test, result)
+
+	fixed, err := os.ReadFile(outputFile)
+	require.NoError(test, err)
+	require.JSONEq(test, `{"DATA_SOURCE":"CUSTOMERS","RECORD_ID":"1","NAME_FULL":"Bob Smith"}`, string(fixed))
+}
+
+// ----------------------------------------------------------------------------
+
+func TestBasicValidate_Read_rename_not_key_value(test *testing.T) {
+	actual, result := transformRecords(test, &validate.BasicValidate{
+		RenameAttributes: []string{"SOURCE_ID"},
+	}, testTransformData, false)
+
+	require.Contains(test, actual, "Fatal error rename is not in KEY=NEWKEY form: SOURCE_ID")
+	require.NotContains(test, actual, "Validated")
+	require.False(test, result)
+}
+
+// ----------------------------------------------------------------------------
+// Helper functions
+// ----------------------------------------------------------------------------
+
+// Read, or fix, the data with the validator and return the output and result.
+func transformRecords(t *testing.T, validator *validate.BasicValidate, data string, isFix bool) (string, bool) {
+	t.Helper()
+
+	reader, writer, cleanUp := mockStdout(t)
+	defer cleanUp()
+
+	filename, moreCleanUp := createTempDataFile(t, data, "jsonl")
+	defer moreCleanUp()
+
+	validator.InputURL = "file://" + filename
+
+	var result bool
+	if isFix {
+		result = validator.Fix(t.Context())
+	} else {
+		result = validator.Read(t.Context())
+	}
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+
+	return string(out), result
+}
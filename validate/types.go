@@ -0,0 +1,83 @@
+package validate
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ----------------------------------------------------------------------------
+// Private methods
+// ----------------------------------------------------------------------------
+
+// Report attribute values that are not strings, lists whose elements are not
+// objects, and objects or lists where a string is expected.  Attributes of the
+// record and of the objects in its lists must be strings, except a numeric
+// RECORD_ID if AllowNumericRecordID is set.  Null values are left to
+// checkEmptyValues.
+func (validate *BasicValidate) checkTypes(object jsonObject) []issue {
+	var issues []issue
+
+	for _, member := range object {
+		list, isList := member.Value.([]any)
+		if !isList {
+			issues = append(issues, validate.checkScalarType(member.Key, member.Value)...)
+
+			continue
+		}
+
+		for index, element := range list {
+			elementPath := fmt.Sprintf("%s[%d]", member.Key, index)
+
+			switch typedElement := element.(type) {
+			case jsonObject:
+				for _, elementMember := range typedElement {
+					issues = append(issues, validate.checkScalarType(joinJSONPath(elementPath, elementMember.Key), elementMember.Value)...)
+				}
+			case nil:
+			default:
+				issues = append(issues, newError(3102, 3103, elementPath, describeType(element)))
+			}
+		}
+	}
+
+	return issues
+}
+
+// ----------------------------------------------------------------------------
+
+func (validate *BasicValidate) checkScalarType(path string, value any) []issue {
+	switch value.(type) {
+	case string, nil:
+		return nil
+	case json.Number:
+		if path == "RECORD_ID" && validate.AllowNumericRecordID {
+			return nil
+		}
+	case jsonObject, []any:
+		return []issue{newError(3104, 3105, path, describeType(value))}
+	}
+
+	return []issue{newError(3100, 3101, path, describeType(value), value)}
+}
+
+// ----------------------------------------------------------------------------
+// Private functions
+// ----------------------------------------------------------------------------
+
+// Name the JSON type of a value, e.g. "a number".
+func describeType(value any) string {
+	switch value.(type) {
+	case bool:
+		return "a boolean"
+	case json.Number:
+		return "a number"
+	case jsonObject:
+		return "an object"
+	case []any:
+		return "a list"
+	case string:
+		return "a string"
+	default:
+		return "null"
+	}
+}
@@ -0,0 +1,77 @@
+//go:build !windows
+
+package validate_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/senzing-garage/validate/validate"
+	"github.com/stretchr/testify/require"
+)
+
+const testTypeData = `{"DATA_SOURCE": "TEST", "RECORD_ID": 1, "NAME_FULL": "Bob Smith"}
+{"DATA_SOURCE": true, "RECORD_ID": "2"}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "3", "NAME_LAST": {"VALUE": "Smith"}, "IS_ACTIVE": false}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "4", "NAMES": ["Bob Smith", {"NAME_FULL": "Robert Smith", "NAME_SUFFIX": 3, "ALIASES": []}]}
+{"DATA_SOURCE": "TEST", "RECORD_ID": "5", "NAMES": [{"NAME_FULL": "Bob Smith"}], "PHONE_NUMBER": "555-1234"}
+`
+
+// ----------------------------------------------------------------------------
+// test attribute value type checks
+// ----------------------------------------------------------------------------
+
+func TestBasicValidate_Read_types(test *testing.T) {
+	actual, result := readTypes(test, false)
+
+	require.Contains(test, actual, "Line 1: RECORD_ID is a number, not a string: 1")
+	require.Contains(test, actual, "Line 2: DATA_SOURCE is a boolean, not a string: true")
+	require.Contains(test, actual, "Line 3: NAME_LAST is an object where a string is expected")
+	require.Contains(test, actual, "Line 3: IS_ACTIVE is a boolean, not a string: false")
+	require.Contains(test, actual, "Line 4: NAMES[0] is a string, not an object")
+	require.Contains(test, actual, "Line 4: NAMES[1].NAME_SUFFIX is a number, not a string: 3")
+	require.Contains(test, actual, "Line 4: NAMES[1].ALIASES is a list where a string is expected")
+	require.NotContains(test, actual, "Line 5:")
+	require.Contains(test, actual, "4 record(s) had numbers or booleans where a string is expected")
+	require.Contains(test, actual, "1 record(s) had lists holding values other than objects")
+	require.Contains(test, actual, "2 record(s) had objects or lists where a string is expected")
+	require.Contains(test, actual, "Validated 5 lines, 4 were bad")
+	require.True(test, result)
+}
+
+// AllowNumericRecordID accepts a RECORD_ID given as a number.
+func TestBasicValidate_Read_types_allow_numeric_record_id(test *testing.T) {
+	actual, result := readTypes(test, true)
+
+	require.NotContains(test, actual, "Line 1:")
+	require.Contains(test, actual, "Line 2: DATA_SOURCE is a boolean, not a string: true")
+	require.Contains(test, actual, "Validated 5 lines, 3 were bad")
+	require.True(test, result)
+}
+
+// ----------------------------------------------------------------------------
+// Helper functions
+// ----------------------------------------------------------------------------
+
+// read testTypeData and return the output and result.
+func readTypes(t *testing.T, allowNumericRecordID bool) (string, bool) {
+	t.Helper()
+
+	reader, writer, cleanUp := mockStdout(t)
+	defer cleanUp()
+
+	filename, moreCleanUp := createTempDataFile(t, testTypeData, "jsonl")
+	defer moreCleanUp()
+
+	validator := &validate.BasicValidate{
+		AllowNumericRecordID: allowNumericRecordID,
+		InputURL:             "file://" + filename,
+	}
+	result := validator.Read(t.Context())
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+
+	return string(out), result
+}
@@ -1,18 +1,19 @@
 package validate
 
 import (
-	"bufio"
+	"cmp"
 	"compress/gzip"
 	"context"
 	"fmt"
 	"io"
-	"net/http"
+	"maps"
 	"net/url"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
+	"time"
 
-	"github.com/senzing-garage/go-helpers/record"
 	"github.com/senzing-garage/go-helpers/wraperror"
 	"github.com/senzing-garage/go-logging/logging"
 )
@@ -21,12 +22,66 @@ import (
 // Types
 // ----------------------------------------------------------------------------
 
+// Counts of records that did not validate, by reason, and of the lines that
+// did not validate for any reason.  A record with several problems is counted
+// once for each reason, but only once in badLines.
+type recordCounts struct {
+	badLines        int
+	emptyDataSource int
+	emptyRecordID   int
+	invalidUTF8     int
+	issues          map[issueSummary]int
+	malformed       int
+	noDataSource    int
+	noRecordID      int
+	notObject       int
+	records         int
+	recordTypes     map[string]int
+	trailingData    int
+}
+
 type BasicValidate struct {
-	InputFileType string
-	InputURL      string
-	JSONOutput    bool
-	logger        logging.Logging
-	LogLevel      string
+	AllowNumericRecordID         bool
+	DefaultDataSource            string
+	DefaultPhoneRegion           string
+	DropAttributes               []string
+	EmptyValuesAsErrors          bool
+	FixOutputFile                string
+	fixOutput                    *outputFile
+	Head                         int
+	HTTPBearerToken              string
+	HTTPCABundle                 string
+	HTTPHeaders                  []string
+	HTTPRetries                  int
+	HTTPRetryBackoff             time.Duration
+	HTTPTimeout                  time.Duration
+	IdentifierFormats            []IdentifierFormat
+	identifierFormatsByAttribute map[string]IdentifierFormat
+	InputEncoding                string
+	InputFileType                string
+	InputURL                     string
+	JSONOutput                   bool
+	JunkNames                    []string
+	junkNames                    map[string]bool
+	LenientKeys                  bool
+	logger                       logging.Logging
+	LogLevel                     string
+	MaxAttributes                int
+	MaxListElements              int
+	MaxRecordBytes               int
+	MaxStringLength              int
+	NormalizedOutputFile         string
+	normalizedOutput             *outputFile
+	progress                     *progress
+	ProgressInterval             time.Duration
+	RecordTypes                  []string
+	RenameAttributes             []string
+	renamesByAttribute           map[string]string
+	Repairs                      []string
+	S3Endpoint                   string
+	SampleRate                   float64
+	SampleSeed                   uint64
+	Skip                         int
 }
 
 // ----------------------------------------------------------------------------
@@ -47,11 +102,33 @@ func (validate *BasicValidate) Read(ctx context.Context) bool {
 		validate.log(3009, logLevel, err)
 	}
 
+	if !validate.isInputEncodingSupported() || !validate.isDefaultPhoneRegionSupported() ||
+		!validate.areRepairsSupported() || !validate.areRenamesSupported() || !validate.isSampleSupported() {
+		return false
+	}
+
+	if !validate.openOutputs() {
+		validate.closeOutputs()
+
+		return false
+	}
+
+	validate.startProgress()
+
+	result := validate.readInput(ctx)
+
+	return validate.closeOutputs() && result
+}
+
+// ----------------------------------------------------------------------------
+
+// Read and validate the input given by InputURL, or stdin if there is none.
+func (validate *BasicValidate) readInput(ctx context.Context) bool {
 	inputURLLen := len(validate.InputURL)
 
-	if inputURLLen == 0 {
+	if inputURLLen == 0 || validate.InputURL == "-" {
 		// assume stdin
-		return validate.ReadStdin()
+		return validate.ReadStdin(ctx)
 	}
 
 	// This assumes the URL includes a schema and path so, minimally:
@@ -62,9 +139,7 @@ func (validate *BasicValidate) Read(ctx context.Context) bool {
 		return false
 	}
 
-	result := validate.validateBasedOnURL()
-
-	return result
+	return validate.validateBasedOnURL(ctx)
 }
 
 /*
@@ -92,34 +167,24 @@ func (validate *BasicValidate) SetLogLevel(ctx context.Context, logLevelName str
 	return wraperror.Errorf(err, wraperror.NoMessage)
 }
 
-// ----------------------------------------------------------------------------
-// Private methods
-//  	response, err := http.Get(jsonURL) // #nosec:G107
-// ----------------------------------------------------------------------------
-
 // ----------------------------------------------------------------------------
 
-// opens and reads a JSONL resource.
-func (validate *BasicValidate) ReadJSONLResource(jsonURL string) bool {
-	//nolint:noctx
-	response, err := http.Get(jsonURL) //nolint:gosec
-	if err != nil {
-		validate.log(5003, jsonURL, err)
-
+// opens and reads a JSONL or JSON resource.
+func (validate *BasicValidate) ReadJSONLResource(ctx context.Context, jsonURL string) bool {
+	body, isOK := validate.openHTTPResource(ctx, jsonURL, 5003)
+	if !isOK {
 		return false
 	}
 
-	defer response.Body.Close()
+	defer body.Close()
 
-	validate.ValidateLines(response.Body)
-
-	return true
+	return validate.validateStream(ctx, body, jsonURL)
 }
 
 // ----------------------------------------------------------------------------
 
-// opens and reads a JSONL file.
-func (validate *BasicValidate) ReadJSONLFile(jsonFile string) bool {
+// opens and reads a JSONL or JSON file.
+func (validate *BasicValidate) ReadJSONLFile(ctx context.Context, jsonFile string) bool {
 	jsonFile = filepath.Clean(jsonFile)
 
 	file, err := os.Open(filepath.Clean(jsonFile))
@@ -131,15 +196,14 @@ func (validate *BasicValidate) ReadJSONLFile(jsonFile string) bool {
 
 	defer file.Close()
 
-	validate.ValidateLines(file)
-
-	return true
+	return validate.validateStream(ctx, validate.countBytes(file, fileSize(file)), jsonFile)
 }
 
 // ----------------------------------------------------------------------------
 
-// opens and reads a JSONL that has been piped to stdin.
-func (validate *BasicValidate) ReadStdin() bool {
+// opens and reads a JSONL or JSON, GZIPped if the input file type is GZ, that
+// has been piped or redirected to stdin.
+func (validate *BasicValidate) ReadStdin(ctx context.Context) bool {
 	info, err := os.Stdin.Stat()
 	if err != nil {
 		validate.log(5005, err)
@@ -147,33 +211,47 @@ func (validate *BasicValidate) ReadStdin() bool {
 		return false
 	}
 
-	if info.Mode()&os.ModeNamedPipe == os.ModeNamedPipe {
-		reader := bufio.NewReader(os.Stdin)
-		validate.ValidateLines(reader)
+	// A terminal is a character device; pipes, sockets and files are not.
+	if info.Mode()&os.ModeCharDevice == os.ModeCharDevice {
+		validate.log(5006)
 
-		return true
+		return false
 	}
 
-	validate.log(5006, err)
+	stdin := validate.countBytes(os.Stdin, fileSize(os.Stdin))
 
-	return false
+	if validate.isGZIP("") {
+		validate.log(2231)
+
+		reader, err := gzip.NewReader(stdin)
+		if err != nil {
+			validate.log(5022, err)
+
+			return false
+		}
+
+		defer reader.Close()
+
+		return validate.validateStream(ctx, reader, "")
+	}
+
+	validate.log(2230)
+
+	return validate.validateStream(ctx, stdin, "")
 }
 
 // ----------------------------------------------------------------------------
 
-// opens and reads a JSONL resource that has been GZIPped.
-func (validate *BasicValidate) ReadGZIPResource(gzURL string) bool {
-	//nolint:noctx
-	response, err := http.Get(gzURL) //nolint:gosec
-	if err != nil {
-		validate.log(5009, gzURL, err)
-
+// opens and reads a JSONL or JSON resource that has been GZIPped.
+func (validate *BasicValidate) ReadGZIPResource(ctx context.Context, gzURL string) bool {
+	body, isOK := validate.openHTTPResource(ctx, gzURL, 5009)
+	if !isOK {
 		return false
 	}
 
-	defer response.Body.Close()
+	defer body.Close()
 
-	reader, err := gzip.NewReader(response.Body)
+	reader, err := gzip.NewReader(body)
 	if err != nil {
 		validate.log(5010, gzURL, err)
 
@@ -182,15 +260,13 @@ func (validate *BasicValidate) ReadGZIPResource(gzURL string) bool {
 
 	defer reader.Close()
 
-	validate.ValidateLines(reader)
-
-	return true
+	return validate.validateStream(ctx, reader, gzURL)
 }
 
 // ----------------------------------------------------------------------------
 
-// opens and reads a JSONL file that has been GZIPped.
-func (validate *BasicValidate) ReadGZIPFile(gzFile string) bool {
+// opens and reads a JSONL or JSON file that has been GZIPped.
+func (validate *BasicValidate) ReadGZIPFile(ctx context.Context, gzFile string) bool {
 	gzFile = filepath.Clean(gzFile)
 
 	gzipfile, err := os.Open(filepath.Clean(gzFile))
@@ -202,7 +278,7 @@ func (validate *BasicValidate) ReadGZIPFile(gzFile string) bool {
 
 	defer gzipfile.Close()
 
-	reader, err := gzip.NewReader(gzipfile)
+	reader, err := gzip.NewReader(validate.countBytes(gzipfile, fileSize(gzipfile)))
 	if err != nil {
 		validate.log(5008, gzFile, err)
 
@@ -211,77 +287,201 @@ func (validate *BasicValidate) ReadGZIPFile(gzFile string) bool {
 
 	defer reader.Close()
 
-	validate.ValidateLines(reader)
-
-	return true
+	return validate.validateStream(ctx, reader, gzFile)
 }
 
 // ----------------------------------------------------------------------------
 
-// validate that each line read from the reader is a valid record.
-func (validate *BasicValidate) ValidateLines(reader io.Reader) {
-	scanner := bufio.NewScanner(reader)
+// validate that each line read from the reader is a valid record, or only the
+// lines selected by Skip, Head and SampleRate.  Returns false if the reader
+// failed, or the context was canceled, before all lines were read; the summary
+// logged is then of the lines read until that point.
+func (validate *BasicValidate) ValidateLines(ctx context.Context, reader io.Reader) bool {
+	lines := newLineReader(reader, validate.lineLimit())
 	totalLines := 0
-	noRecordID := 0
-	noDataSource := 0
-	malformed := 0
-	badRecord := 0
+	counts := recordCounts{}
+	sample := validate.newRecordSample("lines", 2210)
+
+	for lines.scan() {
+		if ctx.Err() != nil {
+			break
+		}
 
-	for scanner.Scan() {
 		totalLines++
-		str := strings.TrimSpace(scanner.Text())
+		if sample.isDone(totalLines) {
+			break
+		}
+
+		if !sample.isSelected(totalLines) {
+			continue
+		}
+
+		location := fmt.Sprintf("Line %d", totalLines)
+		if lines.isTooLong {
+			validate.validateTooLongLine(&counts, location, lines.size)
+
+			continue
+		}
+
+		str := strings.TrimSpace(lines.text())
 		// ignore blank lines
 		if len(str) > 0 {
-			valid, err := record.Validate(str)
-			if !valid {
-				if err != nil {
-					switch {
-					case strings.Contains(err.Error(), "RECORD_ID"):
-						validate.log(3005, totalLines)
-
-						noRecordID++
-					case strings.Contains(err.Error(), "DATA_SOURCE"):
-						validate.log(3006, totalLines)
-
-						noDataSource++
-					case strings.Contains(err.Error(), "not well formed"):
-						validate.log(3007, totalLines)
-
-						malformed++
-					default:
-						validate.log(3008, totalLines)
-
-						badRecord++
-					}
-				}
-			}
+			validate.fixLineEnding(location, lines.isCRLF)
+			validate.validateRecord(&counts, location, str)
 		}
 	}
 
-	if noRecordID > 0 {
-		validate.log(3001, noRecordID)
-	}
+	isCanceled := validate.logCanceled(ctx, sample.read, "lines")
 
-	if noDataSource > 0 {
-		validate.log(3002, noDataSource)
-	}
+	validate.logCounts(counts)
+	validate.logSample(sample, counts.bad())
 
-	if malformed > 0 {
-		validate.log(3003, malformed)
+	if isCanceled {
+		return false
 	}
 
-	if badRecord > 0 {
-		validate.log(3004, badRecord)
+	if lines.err != nil {
+		validate.log(5021, totalLines, lines.err)
+
+		return false
 	}
 
-	validate.log(2210, totalLines, noRecordID+noDataSource+malformed+badRecord)
+	return true
 }
 
 // ----------------------------------------------------------------------------
 // Private methods
 // ----------------------------------------------------------------------------
 
-func (validate *BasicValidate) validateBasedOnURL() bool {
+func (validate *BasicValidate) isGZIP(path string) bool {
+	return strings.HasSuffix(path, "gz") || strings.ToUpper(validate.InputFileType) == "GZ"
+}
+
+func (validate *BasicValidate) isJSON(path string) bool {
+	return strings.HasSuffix(path, "json") || strings.ToUpper(validate.InputFileType) == "JSON"
+}
+
+func (validate *BasicValidate) isJSONL(path string) bool {
+	return strings.HasSuffix(path, "jsonl") || strings.ToUpper(validate.InputFileType) == "JSONL"
+}
+
+// Log that the context was canceled, if it was, after the given number of
+// records, and return whether it was.
+func (validate *BasicValidate) logCanceled(ctx context.Context, records int, noun string) bool {
+	if ctx.Err() == nil {
+		return false
+	}
+
+	validate.log(5038, records, noun, context.Cause(ctx))
+
+	return true
+}
+
+// Log the summary of records that did not validate.
+func (validate *BasicValidate) logCounts(counts recordCounts) {
+	if counts.noRecordID > 0 {
+		validate.log(3001, counts.noRecordID)
+	}
+
+	if counts.noDataSource > 0 {
+		validate.log(3002, counts.noDataSource)
+	}
+
+	if counts.malformed > 0 {
+		validate.log(3003, counts.malformed)
+	}
+
+	if counts.emptyRecordID > 0 {
+		validate.log(3018, counts.emptyRecordID)
+	}
+
+	if counts.emptyDataSource > 0 {
+		validate.log(3016, counts.emptyDataSource)
+	}
+
+	if counts.notObject > 0 {
+		validate.log(3012, counts.notObject)
+	}
+
+	if counts.trailingData > 0 {
+		validate.log(3014, counts.trailingData)
+	}
+
+	if counts.invalidUTF8 > 0 {
+		validate.log(3010, counts.invalidUTF8)
+	}
+
+	summaries := slices.SortedFunc(maps.Keys(counts.issues), func(summary issueSummary, other issueSummary) int {
+		return cmp.Or(cmp.Compare(summary.id, other.id), strings.Compare(summary.detail, other.detail))
+	})
+	for _, summary := range summaries {
+		if summary.detail == "" {
+			validate.log(summary.id, counts.issues[summary])
+		} else {
+			validate.log(summary.id, counts.issues[summary], summary.detail)
+		}
+	}
+
+	validate.logRecordTypes(counts)
+}
+
+// Validate a single record, logging and counting every problem found.  The
+// location, e.g. "Line 12", identifies the record in the input.
+func (validate *BasicValidate) validateRecord(counts *recordCounts, location string, str string) {
+	// Checked before the record is repaired or transformed, which replaces
+	// invalid UTF-8 with U+FFFD.
+	isBad := validate.validateUTF8(counts, location, str)
+
+	str = validate.transformRecord(location, validate.repairRecord(location, str))
+
+	object, isStructureBad := validate.validateStructure(counts, location, str)
+	isBad = isStructureBad || isBad
+
+	if object != nil {
+		isBad = validate.validateAttributes(counts, location, str, object) || isBad
+	}
+
+	counts.records++
+
+	if isBad {
+		counts.badLines++
+	}
+
+	validate.writeNormalized(str, object)
+	validate.writeFixed(str)
+	validate.reportProgress(counts)
+}
+
+// Check that a record is a JSON object and has the required fields, logging
+// and counting every problem found.  Returns the parsed record, or nil if it is
+// not a JSON object, and whether it failed any check.
+func (validate *BasicValidate) validateStructure(counts *recordCounts, location string, str string) (jsonObject, bool) {
+	object, err := checkRecord(str)
+	if err != nil {
+		validate.reportRecordErrors(counts, location, err)
+
+		return object, true
+	}
+
+	return object, false
+}
+
+// Check that a record is valid UTF-8, logging and counting it if it is not.
+// Returns whether it is not.
+func (validate *BasicValidate) validateUTF8(counts *recordCounts, location string, str string) bool {
+	offset := invalidUTF8Offset(str)
+	if offset < 0 {
+		return false
+	}
+
+	validate.log(3011, location, offset)
+
+	counts.invalidUTF8++
+
+	return true
+}
+
+func (validate *BasicValidate) validateBasedOnURL(ctx context.Context) bool {
 	validate.log(2200, validate.InputURL)
 
 	parsedURL, err := url.Parse(validate.InputURL)
@@ -294,30 +494,50 @@ func (validate *BasicValidate) validateBasedOnURL() bool {
 	switch parsedURL.Scheme {
 	case "file":
 		switch {
-		case strings.HasSuffix(parsedURL.Path, "jsonl"), strings.ToUpper(validate.InputFileType) == "JSONL":
+		case validate.isJSONL(parsedURL.Path):
 			validate.log(2201)
 
-			return validate.ReadJSONLFile(parsedURL.Path)
-		case strings.HasSuffix(parsedURL.Path, "gz"), strings.ToUpper(validate.InputFileType) == "GZ":
+			return validate.ReadJSONLFile(ctx, parsedURL.Path)
+		case validate.isGZIP(parsedURL.Path):
 			validate.log(2203)
 
-			return validate.ReadGZIPFile(parsedURL.Path)
+			return validate.ReadGZIPFile(ctx, parsedURL.Path)
+		case validate.isJSON(parsedURL.Path):
+			validate.log(2202)
+
+			return validate.ReadJSONLFile(ctx, parsedURL.Path)
+		case validate.isParquet(parsedURL.Path):
+			validate.log(2215)
+
+			return validate.ReadParquetFile(ctx, parsedURL.Path)
 		default:
 			validate.log(5011)
 		}
 	case "http", "https":
 		switch {
-		case strings.HasSuffix(parsedURL.Path, "jsonl"), strings.ToUpper(validate.InputFileType) == "JSONL":
+		case validate.isJSONL(parsedURL.Path):
 			validate.log(2204)
 
-			return validate.ReadJSONLResource(validate.InputURL)
-		case strings.HasSuffix(parsedURL.Path, "gz"), strings.ToUpper(validate.InputFileType) == "GZ":
+			return validate.ReadJSONLResource(ctx, validate.InputURL)
+		case validate.isGZIP(parsedURL.Path):
 			validate.log(2205)
 
-			return validate.ReadGZIPResource(validate.InputURL)
+			return validate.ReadGZIPResource(ctx, validate.InputURL)
+		case validate.isJSON(parsedURL.Path):
+			validate.log(2213)
+
+			return validate.ReadJSONLResource(ctx, validate.InputURL)
+		case validate.isParquet(parsedURL.Path):
+			validate.log(2216)
+
+			return validate.ReadParquetResource(ctx, validate.InputURL)
 		default:
 			validate.log(5012)
 		}
+	case "s3":
+		return validate.validateBasedOnS3URL(ctx, parsedURL)
+	case "stdin":
+		return validate.ReadStdin(ctx)
 	default:
 		validate.log(5002, parsedURL.Scheme)
 	}
@@ -325,6 +545,15 @@ func (validate *BasicValidate) validateBasedOnURL() bool {
 	return false
 }
 
+// ----------------------------------------------------------------------------
+// Methods for recordCounts
+// ----------------------------------------------------------------------------
+
+// The number of records that did not validate.
+func (counts recordCounts) bad() int {
+	return counts.badLines
+}
+
 // ----------------------------------------------------------------------------
 // Logging
 // ----------------------------------------------------------------------------
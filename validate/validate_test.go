@@ -5,6 +5,7 @@ package validate_test
 import (
 	"bufio"
 	"compress/gzip"
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -197,7 +198,8 @@ func TestBasicValidate_Read_file_doesnt_exist(test *testing.T) {
 	require.False(test, result)
 }
 
-func TestBasicValidate_Read_stdin_unpipe_error(test *testing.T) {
+// read jsonl redirected to stdin from a regular file.
+func TestBasicValidate_Read_stdin_redirect(test *testing.T) {
 	ctx := test.Context()
 
 	reader, writer, cleanUp := mockStdout(test)
@@ -206,22 +208,119 @@ func TestBasicValidate_Read_stdin_unpipe_error(test *testing.T) {
 	filename, moreCleanUp := createTempDataFile(test, testGoodData, "jsonl")
 	defer moreCleanUp()
 
-	origStdin := os.Stdin
+	cleanUpStdin := mockStdin(test, filename)
+	defer cleanUpStdin()
 
-	defer func() { os.Stdin = origStdin }()
+	validator := &validate.BasicValidate{}
+	result := validator.Read(ctx)
 
-	file, err := os.Open(filepath.Clean(filename))
-	if err != nil {
-		test.Fatal(err)
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	expected := expected12good
+	require.Contains(test, actual, expected)
+	require.True(test, result)
+}
+
+// read jsonl from stdin given explicitly as "-" or "stdin://".
+func TestBasicValidate_Read_stdin_explicit_url(test *testing.T) {
+	for _, inputURL := range []string{"-", "stdin://"} {
+		test.Run(inputURL, func(test *testing.T) {
+			ctx := test.Context()
+
+			reader, writer, cleanUp := mockStdout(test)
+			defer cleanUp()
+
+			filename, moreCleanUp := createTempDataFile(test, testBadData, "jsonl")
+			defer moreCleanUp()
+
+			cleanUpStdin := mockStdin(test, filename)
+			defer cleanUpStdin()
+
+			validator := &validate.BasicValidate{
+				InputURL: inputURL,
+			}
+			result := validator.Read(ctx)
+
+			writer.Close()
+
+			out, _ := io.ReadAll(reader)
+			actual := string(out)
+
+			expected := expected16good4bad
+			require.Contains(test, actual, expected)
+			require.True(test, result)
+		})
 	}
+}
 
-	os.Stdin = file
+// read gzipped jsonl from stdin.
+func TestBasicValidate_Read_stdin_gz(test *testing.T) {
+	ctx := test.Context()
 
-	defer func() {
-		if err := file.Close(); err != nil {
-			test.Fatal(err)
-		}
-	}()
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	filename, moreCleanUp := createTempGZIPDataFile(test, testGoodData)
+	defer moreCleanUp()
+
+	cleanUpStdin := mockStdin(test, filename)
+	defer cleanUpStdin()
+
+	validator := &validate.BasicValidate{
+		InputFileType: "GZ",
+		InputURL:      "-",
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "Validating GZIP from stdin")
+	require.Contains(test, actual, expected12good)
+	require.True(test, result)
+}
+
+// attempt to read gzipped jsonl from stdin, but stdin isn't gzipped.
+func TestBasicValidate_Read_stdin_gz_not_gzipped(test *testing.T) {
+	ctx := test.Context()
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	filename, moreCleanUp := createTempDataFile(test, testGoodData, "jsonl")
+	defer moreCleanUp()
+
+	cleanUpStdin := mockStdin(test, filename)
+	defer cleanUpStdin()
+
+	validator := &validate.BasicValidate{
+		InputFileType: "GZ",
+	}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "Fatal error reading GZIPped stdin")
+	require.False(test, result)
+}
+
+// attempt to read stdin, but stdin is a character device like a terminal.
+func TestBasicValidate_Read_stdin_unpipe_error(test *testing.T) {
+	ctx := test.Context()
+
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	cleanUpStdin := mockStdin(test, os.DevNull)
+	defer cleanUpStdin()
 
 	validator := &validate.BasicValidate{}
 	result := validator.Read(ctx)
@@ -636,7 +735,7 @@ func TestBasicValidate_readJsonlFile(test *testing.T) {
 	validator := &validate.BasicValidate{
 		InputURL: "file://" + filename,
 	}
-	result := validator.ReadJSONLFile(filename)
+	result := validator.ReadJSONLFile(test.Context(), filename)
 
 	writer.Close()
 
@@ -659,7 +758,7 @@ func TestBasicValidate_readJsonlFile_bad(test *testing.T) {
 	validator := &validate.BasicValidate{
 		InputURL: "file://" + filename,
 	}
-	result := validator.ReadJSONLFile(filename)
+	result := validator.ReadJSONLFile(test.Context(), filename)
 
 	writer.Close()
 
@@ -742,7 +841,7 @@ func TestBasicValidate_readGzipFile(test *testing.T) {
 	validator := &validate.BasicValidate{
 		InputURL: "file://" + filename,
 	}
-	result := validator.ReadGZIPFile(filename)
+	result := validator.ReadGZIPFile(test.Context(), filename)
 
 	writer.Close()
 
@@ -765,7 +864,7 @@ func TestBasicValidate_readGzipFile_bad(test *testing.T) {
 	validator := &validate.BasicValidate{
 		InputURL: "file://" + filename,
 	}
-	result := validator.ReadGZIPFile(filename)
+	result := validator.ReadGZIPFile(test.Context(), filename)
 
 	writer.Close()
 
@@ -787,7 +886,7 @@ func TestBasicValidate_readGzipFile_file_does_not_exist(test *testing.T) {
 	validator := &validate.BasicValidate{
 		InputURL: "file://" + filename,
 	}
-	result := validator.ReadGZIPFile(filename)
+	result := validator.ReadGZIPFile(test.Context(), filename)
 
 	writer.Close()
 
@@ -810,7 +909,7 @@ func TestBasicValidate_readGzipFile_not_a_gzip_file(test *testing.T) {
 	validator := &validate.BasicValidate{
 		InputURL: "file://" + filename,
 	}
-	result := validator.ReadGZIPFile(filename)
+	result := validator.ReadGZIPFile(test.Context(), filename)
 
 	writer.Close()
 
@@ -833,62 +932,62 @@ func TestBasicValidate_readStdin(test *testing.T) {
 	filename, moreCleanUp := createTempDataFile(test, testGoodData, "jsonl")
 	defer moreCleanUp()
 
-	origStdin := os.Stdin
-
-	defer func() { os.Stdin = origStdin }()
-
-	file, err := os.Open(filepath.Clean(filename))
-	if err != nil {
-		test.Fatal(err)
-	}
-
-	os.Stdin = file
-
-	defer func() {
-		if err := file.Close(); err != nil {
-			test.Fatal(err)
-		}
-	}()
+	cleanUpStdin := mockStdin(test, filename)
+	defer cleanUpStdin()
 
 	validator := &validate.BasicValidate{}
-	result := validator.ReadStdin()
+	result := validator.ReadStdin(test.Context())
 
 	writer.Close()
 
 	out, _ := io.ReadAll(reader)
 	actual := string(out)
 
-	expected := expectedFatalError
+	expected := expected12good
 	require.Contains(test, actual, expected)
-	require.False(test, result)
+	require.True(test, result)
 }
 
-func TestBasicValidate_readStdin_unpipe_error(test *testing.T) {
+func TestBasicValidate_readStdin_pipe(test *testing.T) {
 	reader, writer, cleanUp := mockStdout(test)
 	defer cleanUp()
 
-	filename, moreCleanUp := createTempDataFile(test, testGoodData, "jsonl")
-	defer moreCleanUp()
+	stdinReader, stdinWriter, err := os.Pipe()
+	require.NoError(test, err)
 
 	origStdin := os.Stdin
 
 	defer func() { os.Stdin = origStdin }()
 
-	file, err := os.Open(filepath.Clean(filename))
-	if err != nil {
-		test.Fatal(err)
-	}
-
-	os.Stdin = file
+	os.Stdin = stdinReader
 
-	defer func() {
-		if err := file.Close(); err != nil {
-			test.Fatal(err)
-		}
+	go func() {
+		_, _ = io.WriteString(stdinWriter, testBadData)
+		stdinWriter.Close()
 	}()
 
 	validator := &validate.BasicValidate{}
-	result := validator.ReadStdin()
+	result := validator.ReadStdin(test.Context())
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	expected := expected16good4bad
+	require.Contains(test, actual, expected)
+	require.True(test, result)
+}
+
+func TestBasicValidate_readStdin_unpipe_error(test *testing.T) {
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	cleanUpStdin := mockStdin(test, os.DevNull)
+	defer cleanUpStdin()
+
+	validator := &validate.BasicValidate{}
+	result := validator.ReadStdin(test.Context())
 
 	writer.Close()
 
@@ -910,7 +1009,7 @@ func TestBasicValidate_validateLines(test *testing.T) {
 	defer cleanUp()
 
 	validator := &validate.BasicValidate{}
-	validator.ValidateLines(strings.NewReader(testGoodData))
+	validator.ValidateLines(test.Context(), strings.NewReader(testGoodData))
 
 	writer.Close()
 
@@ -927,7 +1026,7 @@ func TestBasicValidate_validateLines_with_validation_errors(test *testing.T) {
 	defer cleanUp()
 
 	validator := &validate.BasicValidate{}
-	validator.ValidateLines(strings.NewReader(testBadData))
+	validator.ValidateLines(test.Context(), strings.NewReader(testBadData))
 
 	writer.Close()
 
@@ -938,6 +1037,58 @@ func TestBasicValidate_validateLines_with_validation_errors(test *testing.T) {
 	require.Contains(test, actual, expected)
 }
 
+// validate lines until the context is canceled, after the first three.
+func TestBasicValidate_validateLines_canceled(test *testing.T) {
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	ctx, cancel := context.WithCancel(test.Context())
+	defer cancel()
+
+	lines := strings.SplitAfter(testGoodData, "\n")
+	input := io.MultiReader(
+		strings.NewReader(strings.Join(lines[:3], "")),
+		cancelOnRead{cancel: cancel},
+		strings.NewReader(strings.Join(lines[3:], "")),
+	)
+
+	validator := &validate.BasicValidate{}
+	result := validator.ValidateLines(ctx, input)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "Canceled after reading 3 lines, so the summary is of those only: context canceled")
+	require.Contains(test, actual, "Validated 3 lines, 0 were bad")
+	require.False(test, result)
+}
+
+// read with a context canceled before any line is read.
+func TestBasicValidate_Read_canceled(test *testing.T) {
+	reader, writer, cleanUp := mockStdout(test)
+	defer cleanUp()
+
+	filename, moreCleanUp := createTempDataFile(test, testGoodData, "jsonl")
+	defer moreCleanUp()
+
+	ctx, cancel := context.WithCancel(test.Context())
+	cancel()
+
+	validator := &validate.BasicValidate{InputURL: "file://" + filename}
+	result := validator.Read(ctx)
+
+	writer.Close()
+
+	out, _ := io.ReadAll(reader)
+	actual := string(out)
+
+	require.Contains(test, actual, "Canceled after reading 0 lines")
+	require.Contains(test, actual, "Validated 0 lines, 0 were bad")
+	require.False(test, result)
+}
+
 // validate lines with no record validation errors, json output
 // func TestBasicValidate_validateLines_jsonOutput(test *testing.T) {
 
@@ -984,6 +1135,20 @@ func TestBasicValidate_validateLines_with_validation_errors(test *testing.T) {
 // Helper functions
 // ----------------------------------------------------------------------------
 
+// An empty reader that cancels a context when it is read, e.g. part way
+// through an io.MultiReader.
+type cancelOnRead struct {
+	cancel context.CancelFunc
+}
+
+func (reader cancelOnRead) Read([]byte) (int, error) {
+	reader.cancel()
+
+	return 0, io.EOF
+}
+
+// ----------------------------------------------------------------------------
+
 // create a tempdata file with the given content and extension.
 func createTempDataFile(t *testing.T, content string, fileextension string) (string, func()) {
 	t.Helper()
@@ -1066,6 +1231,25 @@ func serveResource(t *testing.T, filename string) (*http.Server, *net.Listener,
 	return &server, &listener, port
 }
 
+// replace stdin with the named file for testing.
+func mockStdin(t *testing.T, filename string) func() {
+	t.Helper()
+
+	origStdin := os.Stdin
+
+	file, err := os.Open(filepath.Clean(filename))
+	require.NoError(t, err)
+
+	os.Stdin = file
+
+	return func() {
+		os.Stdin = origStdin
+
+		err := file.Close()
+		require.NoError(t, err)
+	}
+}
+
 // capture stdout for testing.
 func mockStdout(t *testing.T) (*os.File, *os.File, func()) {
 	t.Helper()
@@ -1085,22 +1269,22 @@ func mockStdout(t *testing.T) (*os.File, *os.File, func()) {
 }
 
 // capture stderr for testing
-// func mockStderr(test *testing.T) (reader *os.File, writer *os.File, cleanUp func()) {
-// 	test.Helper()
-// 	origStderr := os.Stderr
-// 	reader, writer, err := os.Pipe()
-// 	if err != nil {
-// 		assert.Fail(test, "couldn't get os Pipe: %v", err)
-// 	}
-// 	os.Stderr = writer
-
-// 	return reader,
-// 		writer,
-// 		func() {
-// 			// clean-up
-// 			os.Stderr = origStderr
-// 		}
-// }
+func mockStderr(t *testing.T) (*os.File, *os.File, func()) {
+	t.Helper()
+
+	origStderr := os.Stderr
+	reader, writer, err := os.Pipe()
+	require.NoErrorf(t, err, "couldn't get os Pipe: %v", err)
+
+	os.Stderr = writer
+
+	return reader,
+		writer,
+		func() {
+			// clean-up
+			os.Stderr = origStderr
+		}
+}
 
 var testGoodData = `{"DATA_SOURCE": "ICIJ", "RECORD_ID": "24000001", "ENTITY_TYPE": "ADDRESS", "RECORD_TYPE": "ADDRESS", "icij_source": "BAHAMAS", "icij_type": "ADDRESS", "COUNTRIES": [{"COUNTRY_OF_ASSOCIATION": "BHS"}], "ADDR_FULL": "ANNEX FREDERICK & SHIRLEY STS, P.O. BOX N-4805, NASSAU, BAHAMAS", "REL_ANCHOR_DOMAIN": "ICIJ_ID", "REL_ANCHOR_KEY": "24000001"}
 {"DATA_SOURCE": "ICIJ", "RECORD_ID": "24000002", "ENTITY_TYPE": "ADDRESS", "RECORD_TYPE": "ADDRESS", "icij_source": "BAHAMAS", "icij_type": "ADDRESS", "COUNTRIES": [{"COUNTRY_OF_ASSOCIATION": "BHS"}], "ADDR_FULL": "SUITE E-2,UNION COURT BUILDING, P.O. BOX N-8188, NASSAU, BAHAMAS", "REL_ANCHOR_DOMAIN": "ICIJ_ID", "REL_ANCHOR_KEY": "24000002"}
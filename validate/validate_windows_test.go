@@ -887,18 +887,18 @@ func TestValidateImpl_readStdin(t *testing.T) {
 	}()
 
 	validator := &BasicValidate{}
-	result := validator.ReadStdin()
+	result := validator.ReadStdin(t.Context())
 
 	w.Close()
 	out, _ := io.ReadAll(r)
 	got := string(out)
 
-	want := "Fatal error stdin not piped"
+	want := "Validated 12 lines, 0 were bad"
 	if !strings.Contains(got, want) {
 		t.Errorf("BasicValidate.readStdin() = %v, want %v", got, want)
 	}
-	if result == true {
-		t.Errorf("BasicValidate.readStdin() = %v, want false", result)
+	if result == false {
+		t.Errorf("BasicValidate.readStdin() = %v, want true", result)
 	}
 }
 
@@ -906,13 +906,10 @@ func TestValidateImpl_readStdin_unpipe_error(t *testing.T) {
 	r, w, cleanUp := mockStdout(t)
 	defer cleanUp()
 
-	filename, moreCleanUp := createTempDataFile(t, testGoodData, "jsonl")
-	defer moreCleanUp()
-
 	origStdin := os.Stdin
 	defer func() { os.Stdin = origStdin }()
 
-	file, err := os.Open(filepath.Clean(filename))
+	file, err := os.Open(os.DevNull)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -925,7 +922,7 @@ func TestValidateImpl_readStdin_unpipe_error(t *testing.T) {
 	}()
 
 	validator := &BasicValidate{}
-	result := validator.ReadStdin()
+	result := validator.ReadStdin(t.Context())
 
 	w.Close()
 	out, _ := io.ReadAll(r)
@@ -950,7 +947,7 @@ func TestValidateImpl_validateLines(t *testing.T) {
 	defer cleanUp()
 
 	validator := &BasicValidate{}
-	validator.ValidateLines(strings.NewReader(testGoodData))
+	validator.ValidateLines(t.Context(), strings.NewReader(testGoodData))
 
 	w.Close()
 	out, _ := io.ReadAll(r)
@@ -968,7 +965,7 @@ func TestValidateImpl_validateLines_with_validation_errors(t *testing.T) {
 	defer cleanUp()
 
 	validator := &BasicValidate{}
-	validator.ValidateLines(strings.NewReader(testBadData))
+	validator.ValidateLines(t.Context(), strings.NewReader(testBadData))
 
 	w.Close()
 	out, _ := io.ReadAll(r)